@@ -0,0 +1,149 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"log"
+	"math/rand"
+
+	"github.com/diegoclair/grpc-go-course/blog/blogpb"
+)
+
+const uploadChunkSize = 32 * 1024
+
+// uploadAttachment sends the metadata, the content in chunks and then its checksum
+func uploadAttachment(c blogpb.BlogServiceClient, metadata *blogpb.AttachmentMetadata, content []byte, checksum string) (*blogpb.Attachment, error) {
+
+	stream, err := c.UploadAttachment(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	requests := []*blogpb.UploadAttachmentRequest{
+		{Data: &blogpb.UploadAttachmentRequest_Metadata{Metadata: metadata}},
+	}
+	for start := 0; start < len(content); start += uploadChunkSize {
+		end := start + uploadChunkSize
+		if end > len(content) {
+			end = len(content)
+		}
+		requests = append(requests, &blogpb.UploadAttachmentRequest{Data: &blogpb.UploadAttachmentRequest_Chunk{Chunk: content[start:end]}})
+	}
+	requests = append(requests, &blogpb.UploadAttachmentRequest{Data: &blogpb.UploadAttachmentRequest_Sha256{Sha256: checksum}})
+
+	for _, req := range requests {
+		err := stream.Send(req)
+		if err == io.EOF {
+			break //the server has already answered, probably with an error that we get in the CloseAndRecv
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	res, err := stream.CloseAndRecv()
+	if err != nil {
+		return nil, err
+	}
+	return res.GetAttachment(), nil
+}
+
+// downloadAttachment receives the content from the offset, if maxChunks > 0 it stops after receiving them
+func downloadAttachment(c blogpb.BlogServiceClient, attachmentID string, offset int64, maxChunks int) ([]byte, error) {
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stream, err := c.DownloadAttachment(ctx, &blogpb.DownloadAttachmentRequest{AttachmentId: attachmentID, Offset: offset})
+	if err != nil {
+		return nil, err
+	}
+
+	var content bytes.Buffer
+	for chunks := 0; maxChunks <= 0 || chunks < maxChunks; chunks++ {
+		res, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if res.GetAttachment() != nil {
+			fmt.Printf("Downloading %v (%v bytes) from offset %v\n", res.GetAttachment().GetFileName(), res.GetAttachment().GetSize(), res.GetOffset())
+		}
+		content.Write(res.GetChunk())
+	}
+
+	return content.Bytes(), nil
+}
+
+func doAttachments(c blogpb.BlogServiceClient, blogID string) {
+
+	fmt.Println("Uploading an attachment...")
+
+	content := newImage()
+	sum := sha256.Sum256(content)
+	checksum := hex.EncodeToString(sum[:])
+	metadata := &blogpb.AttachmentMetadata{
+		BlogId:      blogID,
+		FileName:    "noise.png",
+		ContentType: "image/png",
+		Size:        int64(len(content)),
+	}
+
+	//these ones should return INVALID_ARGUMENT, because the content is not a png, and DATA_LOSS
+	notImage := []byte("this is not an image")
+	notImageSum := sha256.Sum256(notImage)
+	_, err := uploadAttachment(c, &blogpb.AttachmentMetadata{BlogId: blogID, FileName: "fake.png", ContentType: "image/png"}, notImage, hex.EncodeToString(notImageSum[:]))
+	if err != nil {
+		fmt.Printf("Error happened while uploading: %v\n", err)
+	}
+	_, err = uploadAttachment(c, metadata, content, "bad-checksum")
+	if err != nil {
+		fmt.Printf("Error happened while uploading: %v\n", err)
+	}
+
+	attachment, err := uploadAttachment(c, metadata, content, checksum)
+	if err != nil {
+		log.Fatalf("Error while calling UploadAttachment RPC: %v", err)
+	}
+	fmt.Printf("Attachment has been uploaded: %v\n", attachment)
+
+	//we stop after the first chunk, like a broken connection, and resume from where we stopped
+	downloaded, err := downloadAttachment(c, attachment.GetId(), 0, 1)
+	if err != nil {
+		log.Fatalf("Error while calling DownloadAttachment RPC: %v", err)
+	}
+	rest, err := downloadAttachment(c, attachment.GetId(), int64(len(downloaded)), 0)
+	if err != nil {
+		log.Fatalf("Error while calling DownloadAttachment RPC: %v", err)
+	}
+	downloaded = append(downloaded, rest...)
+
+	sum = sha256.Sum256(downloaded)
+	fmt.Printf("Attachment was downloaded: %v bytes, checksum ok: %v\n", len(downloaded), hex.EncodeToString(sum[:]) == attachment.GetSha256())
+}
+
+// newImage returns a png big enough to be sent in a few chunks, the random pixels can't be compressed
+func newImage() []byte {
+	img := image.NewRGBA(image.Rect(0, 0, 256, 256))
+	for x := 0; x < 256; x++ {
+		for y := 0; y < 256; y++ {
+			img.Set(x, y, color.RGBA{R: uint8(rand.Intn(256)), G: uint8(y), B: uint8(x), A: 255})
+		}
+	}
+
+	var buf bytes.Buffer
+	err := png.Encode(&buf, img)
+	if err != nil {
+		log.Fatalf("Error while encoding the image: %v", err)
+	}
+	return buf.Bytes()
+}
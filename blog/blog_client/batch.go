@@ -0,0 +1,100 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/diegoclair/grpc-go-course/blog/blogpb"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func doBatchWriteBlogs(c blogpb.BlogServiceClient, authorID string) {
+
+	fmt.Println("Creating blogs in a batch...")
+
+	res, err := c.BatchWriteBlogs(context.Background(), &blogpb.BatchWriteBlogsRequest{
+		Operations: []*blogpb.BlogWriteOperation{
+			createOperation(authorID, "My First Batch Blog"),
+			createOperation(authorID, "My Second Batch Blog"),
+		},
+	})
+	if err != nil {
+		log.Fatalf("Error while calling BatchWriteBlogs RPC: %v", err)
+	}
+	first, second := res.GetResults()[0].GetBlog(), res.GetResults()[1].GetBlog()
+	fmt.Printf("Blogs were created: %v, %v\n", first.GetId(), second.GetId())
+
+	//the last operation fails, so the first two are not applied either
+	_, err = c.BatchWriteBlogs(context.Background(), &blogpb.BatchWriteBlogsRequest{
+		Operations: []*blogpb.BlogWriteOperation{
+			renameOperation(first, "My Renamed Batch Blog"),
+			deleteOperation(second.GetId()),
+			deleteOperation("000000000000000000000000"),
+		},
+	})
+	if err != nil {
+		fmt.Printf("Error happened while writing the batch: %v\n", err)
+		for _, detail := range status.Convert(err).Details() {
+			if info, ok := detail.(*errdetails.ErrorInfo); ok {
+				fmt.Printf("The operation %v failed with %v\n", info.GetMetadata()["index"], info.GetMetadata()["code"])
+			}
+		}
+	}
+
+	res, err = c.BatchWriteBlogs(context.Background(), &blogpb.BatchWriteBlogsRequest{
+		Operations: []*blogpb.BlogWriteOperation{
+			renameOperation(first, "My Renamed Batch Blog"),
+			deleteOperation(second.GetId()),
+		},
+	})
+	if err != nil {
+		log.Fatalf("Error while calling BatchWriteBlogs RPC: %v", err)
+	}
+	for _, result := range res.GetResults() {
+		fmt.Printf("Blog was written: %v (revision %v, deleted: %v)\n", result.GetBlog().GetTitle(), result.GetBlog().GetRevision(), result.GetBlog().GetDeletedAt() != nil)
+	}
+
+	for _, blogID := range []string{first.GetId(), second.GetId()} {
+		_, err = c.DeleteBlog(context.Background(), &blogpb.DeleteBlogRequest{BlogId: blogID, Permanent: true})
+		if err != nil {
+			log.Fatalf("Error while calling DeleteBlog RPC: %v", err)
+		}
+	}
+}
+
+func createOperation(authorID, title string) *blogpb.BlogWriteOperation {
+	return &blogpb.BlogWriteOperation{
+		Operation: &blogpb.BlogWriteOperation_Create{
+			Create: &blogpb.CreateBlogRequest{
+				Blog: &blogpb.Blog{
+					AuthorId: authorID,
+					Title:    title,
+					Content:  "This blog was created in a batch",
+				},
+			},
+		},
+	}
+}
+
+func renameOperation(blog *blogpb.Blog, title string) *blogpb.BlogWriteOperation {
+	return &blogpb.BlogWriteOperation{
+		Operation: &blogpb.BlogWriteOperation_Update{
+			Update: &blogpb.UpdateBlogRequest{
+				Blog:             &blogpb.Blog{Id: blog.GetId(), Title: title},
+				ExpectedRevision: blog.GetRevision(),
+				UpdateMask:       &fieldmaskpb.FieldMask{Paths: []string{"title"}},
+			},
+		},
+	}
+}
+
+func deleteOperation(blogID string) *blogpb.BlogWriteOperation {
+	return &blogpb.BlogWriteOperation{
+		Operation: &blogpb.BlogWriteOperation_Delete{
+			Delete: &blogpb.DeleteBlogRequest{BlogId: blogID},
+		},
+	}
+}
@@ -1 +1,594 @@
 package main
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/diegoclair/grpc-go-course/blog/blogpb"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	address    = flag.String("address", "localhost:50051", "address of the blog server")
+	useTLS     = flag.Bool("tls", false, "connect with tls, the server certificate is checked with the -ca-file or the system certificates")
+	caFile     = flag.String("ca-file", "", "file with the certificate authority that signed the server certificate, like ssl/ca.crt")
+	serverName = flag.String("server-name", "", "name expected in the server certificate, by default the host of the -address")
+	apiKey     = flag.String("api-key", "", "api key of the tenant, required when the server has a tenants file")
+	output     = flag.String("output", outputTable, "how the results are printed: table or json")
+	timeout    = flag.Duration("timeout", 10*time.Second, "deadline of each request, the watch command doesn't have one")
+)
+
+// apiKeyCredentials sends the api key in the metadata of all the requests
+type apiKeyCredentials string
+
+func (k apiKeyCredentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{"authorization": "Bearer " + string(k)}, nil
+}
+
+// RequireTransportSecurity is false because the examples don't use tls, with -tls the key is not leaked
+func (k apiKeyCredentials) RequireTransportSecurity() bool {
+	return false
+}
+
+func main() {
+
+	flag.Usage = usage
+	flag.Parse()
+	if flag.NArg() == 0 {
+		usage()
+		os.Exit(2)
+	}
+	cmd, ok := findCommand(flag.Arg(0))
+	if !ok {
+		fmt.Fprintf(os.Stderr, "Unknown command %q\n\n", flag.Arg(0))
+		usage()
+		os.Exit(2)
+	}
+	if *output != outputTable && *output != outputJSON {
+		fmt.Fprintf(os.Stderr, "Unknown output %q, it should be %v or %v\n", *output, outputTable, outputJSON)
+		os.Exit(2)
+	}
+
+	cc, err := dial()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Could not connect: %v\n", err)
+		os.Exit(1)
+	}
+	defer cc.Close()
+
+	c := blogpb.NewBlogServiceClient(cc)
+	err = cmd.run(c, flag.Args()[1:])
+	if err != nil {
+		printError(err)
+		cc.Close()
+		os.Exit(1)
+	}
+}
+
+func dial() (*grpc.ClientConn, error) {
+
+	// https://grpc.io/docs/guides/auth/ -> here we can see the docs explaining how to do insecure connection and with TLS/SSL
+	opts := []grpc.DialOption{grpc.WithInsecure()}
+	if *useTLS {
+		config := &tls.Config{ServerName: *serverName}
+		if *caFile != "" {
+			pem, err := ioutil.ReadFile(*caFile)
+			if err != nil {
+				return nil, fmt.Errorf("error while loading the CA trust certificate: %w", err)
+			}
+			config.RootCAs = x509.NewCertPool()
+			if !config.RootCAs.AppendCertsFromPEM(pem) {
+				return nil, fmt.Errorf("the %v has no valid certificate", *caFile)
+			}
+		}
+		opts = []grpc.DialOption{grpc.WithTransportCredentials(credentials.NewTLS(config))}
+	}
+	if *apiKey != "" {
+		opts = append(opts, grpc.WithPerRPCCredentials(apiKeyCredentials(*apiKey)))
+	}
+
+	return grpc.Dial(*address, opts...)
+}
+
+// doExamples calls all the rpcs, creating its own author and blogs
+func doExamples(c blogpb.BlogServiceClient) {
+
+	fmt.Println("Blog client")
+
+	//the blogs and comments must reference an existing author
+	authorID := doCreateAuthor(c)
+
+	blogID := doCreateBlog(c, authorID, "go")
+	doRetryCreateBlog(c, authorID)
+	doCreateInvalidBlog(c)
+	doReadBlog(c, blogID)
+	doUpdateBlog(c, blogID)
+	doUpdateBlogTitle(c, blogID)
+	doGetBlogHistory(c, blogID)
+	doComments(c, blogID, authorID)
+	doAttachments(c, blogID)
+	doPublishBlog(c, blogID)
+	doTrashBlog(c, blogID)
+	doDeleteBlog(c, blogID)
+
+	//we create some blogs to have something to list
+	doCreateBlog(c, authorID, "go", "grpc")
+	doCreateBlog(c, authorID, "go", "mongo")
+	popularBlogID := doCreateBlog(c, authorID, "grpc")
+	doListBlogs(c)
+	doListTags(c)
+	doListBlogsByTags(c, blogpb.TagMatch_TAG_MATCH_ALL, "go", "grpc")
+	doListBlogsByTags(c, blogpb.TagMatch_TAG_MATCH_ANY, "mongo", "grpc")
+	doSearchBlogs(c, `content "first blog"`)
+	doRenderBlog(c, authorID)
+	doStats(c, authorID)
+	doCacheStats(c, popularBlogID)
+	doBatchWriteBlogs(c, authorID)
+
+	//doWatchBlogs(c, 30*time.Second) //keeps printing the changes made by other clients until the timeout
+}
+
+func doCreateAuthor(c blogpb.BlogServiceClient) string {
+
+	fmt.Println("Creating the author...")
+
+	req := &blogpb.CreateAuthorRequest{
+		Author: &blogpb.Author{
+			Name:  "Diego Clair",
+			Email: "diego@example.com",
+		},
+	}
+	res, err := c.CreateAuthor(context.Background(), req)
+	if err != nil {
+		log.Fatalf("Error while calling CreateAuthor RPC: %v", err)
+	}
+	fmt.Printf("Author has been created: %v\n", res.GetAuthor())
+
+	return res.GetAuthor().GetId()
+}
+
+func doCreateBlog(c blogpb.BlogServiceClient, authorID string, tags ...string) string {
+
+	fmt.Println("Creating the blog...")
+
+	req := &blogpb.CreateBlogRequest{
+		Blog: &blogpb.Blog{
+			AuthorId: authorID,
+			Title:    "My First Blog",
+			Content:  "Content of the first blog",
+			Tags:     tags,
+			Category: "Programming",
+		},
+	}
+	res, err := c.CreateBlog(context.Background(), req)
+	if err != nil {
+		log.Fatalf("Error while calling CreateBlog RPC: %v", err)
+	}
+	fmt.Printf("Blog has been created: %v\n", res.GetBlog())
+
+	return res.GetBlog().GetId()
+}
+
+func doCreateInvalidBlog(c blogpb.BlogServiceClient) {
+
+	fmt.Println("Creating an invalid blog...")
+
+	//this one should return INVALID_ARGUMENT, with a violation for each invalid field
+	_, err := c.CreateBlog(context.Background(), &blogpb.CreateBlogRequest{
+		Blog: &blogpb.Blog{
+			AuthorId: "not an id!",
+			Content:  "A blog without title",
+		},
+	})
+	if err != nil {
+		fmt.Printf("Error happened while creating: %v\n", err)
+		for _, detail := range status.Convert(err).Details() {
+			if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+				for _, violation := range badRequest.GetFieldViolations() {
+					fmt.Printf("Field %v: %v\n", violation.GetField(), violation.GetDescription())
+				}
+			}
+		}
+	}
+}
+
+func doReadBlog(c blogpb.BlogServiceClient, blogID string) {
+
+	fmt.Println("Reading the blog...")
+
+	//this one should return NOT_FOUND
+	_, err := c.ReadBlog(context.Background(), &blogpb.ReadBlogRequest{BlogId: "invalid-id"})
+	if err != nil {
+		fmt.Printf("Error happened while reading: %v\n", err)
+	}
+
+	res, err := c.ReadBlog(context.Background(), &blogpb.ReadBlogRequest{BlogId: blogID})
+	if err != nil {
+		log.Fatalf("Error while calling ReadBlog RPC: %v", err)
+	}
+	fmt.Printf("Blog was read: %v\n", res.GetBlog())
+}
+
+func doUpdateBlog(c blogpb.BlogServiceClient, blogID string) {
+
+	fmt.Println("Updating the blog...")
+
+	//we need the current revision, the server only accepts the update if nobody changed the blog after we read it
+	blog, err := c.ReadBlog(context.Background(), &blogpb.ReadBlogRequest{BlogId: blogID})
+	if err != nil {
+		log.Fatalf("Error while calling ReadBlog RPC: %v", err)
+	}
+
+	req := &blogpb.UpdateBlogRequest{
+		Blog: &blogpb.Blog{
+			Id:       blogID,
+			AuthorId: blog.GetBlog().GetAuthorId(),
+			Title:    "My First Blog (edited)",
+			Content:  "Content of the first blog, with some awesome additions!",
+		},
+		ExpectedRevision: blog.GetBlog().GetRevision(),
+	}
+	res, err := c.UpdateBlog(context.Background(), req)
+	if err != nil {
+		log.Fatalf("Error while calling UpdateBlog RPC: %v", err)
+	}
+	fmt.Printf("Blog was updated: %v\n", res.GetBlog())
+
+	//this one should return ABORTED, because the revision that we read is not the current anymore
+	_, err = c.UpdateBlog(context.Background(), req)
+	if err != nil {
+		fmt.Printf("Error happened while updating: %v\n", err)
+	}
+}
+
+func doUpdateBlogTitle(c blogpb.BlogServiceClient, blogID string) {
+
+	fmt.Println("Updating only the blog title...")
+
+	//we only need the revision, so we don't receive the content
+	blog, err := c.ReadBlog(context.Background(), &blogpb.ReadBlogRequest{
+		BlogId:   blogID,
+		ReadMask: &fieldmaskpb.FieldMask{Paths: []string{"revision"}},
+	})
+	if err != nil {
+		log.Fatalf("Error while calling ReadBlog RPC: %v", err)
+	}
+	fmt.Printf("Blog was read with a mask: %v\n", blog.GetBlog())
+
+	//the content is not sent, the server keeps the saved one
+	req := &blogpb.UpdateBlogRequest{
+		Blog: &blogpb.Blog{
+			Id:    blogID,
+			Title: "My First Blog (renamed)",
+		},
+		ExpectedRevision: blog.GetBlog().GetRevision(),
+		UpdateMask:       &fieldmaskpb.FieldMask{Paths: []string{"title"}},
+	}
+	res, err := c.UpdateBlog(context.Background(), req)
+	if err != nil {
+		log.Fatalf("Error while calling UpdateBlog RPC: %v", err)
+	}
+	fmt.Printf("Blog title was updated: %v\n", res.GetBlog())
+
+	//this one should return INVALID_ARGUMENT, because the revision is filled by the server
+	req.UpdateMask.Paths = []string{"title", "revision"}
+	req.ExpectedRevision = res.GetBlog().GetRevision()
+	_, err = c.UpdateBlog(context.Background(), req)
+	if err != nil {
+		fmt.Printf("Error happened while updating: %v\n", err)
+	}
+}
+
+func doGetBlogHistory(c blogpb.BlogServiceClient, blogID string) {
+
+	fmt.Println("Getting the blog history...")
+
+	res, err := c.GetBlogHistory(context.Background(), &blogpb.GetBlogHistoryRequest{BlogId: blogID})
+	if err != nil {
+		log.Fatalf("Error while calling GetBlogHistory RPC: %v", err)
+	}
+	for _, revision := range res.GetRevisions() {
+		fmt.Printf("Revision %v: %v\n", revision.GetRevision(), revision)
+	}
+}
+
+func doDeleteBlog(c blogpb.BlogServiceClient, blogID string) {
+
+	fmt.Println("Deleting the blog permanently...")
+
+	//this one should return FAILED_PRECONDITION, because the blog has comments
+	_, err := c.DeleteBlog(context.Background(), &blogpb.DeleteBlogRequest{BlogId: blogID, Permanent: true})
+	if err != nil {
+		fmt.Printf("Error happened while deleting: %v\n", err)
+	}
+
+	res, err := c.DeleteBlog(context.Background(), &blogpb.DeleteBlogRequest{BlogId: blogID, Permanent: true, Cascade: true})
+	if err != nil {
+		log.Fatalf("Error while calling DeleteBlog RPC: %v", err)
+	}
+	fmt.Printf("Blog was deleted: %v\n", res.GetBlogId())
+}
+
+func doPublishBlog(c blogpb.BlogServiceClient, blogID string) {
+
+	fmt.Println("Publishing the blog...")
+
+	//this one should return FAILED_PRECONDITION, a draft must be reviewed before it is published
+	_, err := c.PublishBlog(context.Background(), &blogpb.PublishBlogRequest{BlogId: blogID})
+	if err != nil {
+		fmt.Printf("Error happened while publishing: %v\n", err)
+	}
+
+	_, err = c.SubmitBlogForReview(context.Background(), &blogpb.SubmitBlogForReviewRequest{BlogId: blogID})
+	if err != nil {
+		log.Fatalf("Error while calling SubmitBlogForReview RPC: %v", err)
+	}
+
+	//the server publishes the blog by itself when the time arrives
+	req := &blogpb.PublishBlogRequest{
+		BlogId:    blogID,
+		PublishAt: timestamppb.New(time.Now().Add(2 * time.Second)),
+	}
+	res, err := c.PublishBlog(context.Background(), req)
+	if err != nil {
+		log.Fatalf("Error while calling PublishBlog RPC: %v", err)
+	}
+	fmt.Printf("Blog was scheduled: %v at %v\n", res.GetBlog().GetStatus(), res.GetBlog().GetPublishAt().AsTime())
+
+	time.Sleep(3 * time.Second)
+	blog, err := c.ReadBlog(context.Background(), &blogpb.ReadBlogRequest{BlogId: blogID})
+	if err != nil {
+		log.Fatalf("Error while calling ReadBlog RPC: %v", err)
+	}
+	fmt.Printf("Blog is %v since %v\n", blog.GetBlog().GetStatus(), blog.GetBlog().GetPublishedAt().AsTime())
+
+	archived, err := c.ArchiveBlog(context.Background(), &blogpb.ArchiveBlogRequest{BlogId: blogID})
+	if err != nil {
+		log.Fatalf("Error while calling ArchiveBlog RPC: %v", err)
+	}
+	fmt.Printf("Blog was archived: %v\n", archived.GetBlog().GetStatus())
+}
+
+func doTrashBlog(c blogpb.BlogServiceClient, blogID string) {
+
+	fmt.Println("Moving the blog to the trash...")
+
+	_, err := c.DeleteBlog(context.Background(), &blogpb.DeleteBlogRequest{BlogId: blogID})
+	if err != nil {
+		log.Fatalf("Error while calling DeleteBlog RPC: %v", err)
+	}
+
+	//this one should return NOT_FOUND, the blogs in the trash can't be read
+	_, err = c.ReadBlog(context.Background(), &blogpb.ReadBlogRequest{BlogId: blogID})
+	if err != nil {
+		fmt.Printf("Error happened while reading: %v\n", err)
+	}
+
+	stream, err := c.ListDeletedBlogs(context.Background(), &blogpb.ListDeletedBlogsRequest{})
+	if err != nil {
+		log.Fatalf("Error while calling ListDeletedBlogs RPC: %v", err)
+	}
+	for {
+		res, err := stream.Recv()
+		if err == io.EOF {
+			//we've reached the end of the stream
+			break
+		}
+		if err != nil {
+			log.Fatalf("Error while reading the stream: %v", err)
+		}
+		fmt.Printf("In the trash until %v: %v\n", res.GetPurgeAt().AsTime(), res.GetBlog().GetId())
+	}
+
+	res, err := c.RestoreBlog(context.Background(), &blogpb.RestoreBlogRequest{BlogId: blogID})
+	if err != nil {
+		log.Fatalf("Error while calling RestoreBlog RPC: %v", err)
+	}
+	fmt.Printf("Blog was restored: %v\n", res.GetBlog())
+}
+
+func doComments(c blogpb.BlogServiceClient, blogID, authorID string) {
+
+	fmt.Println("Commenting the blog...")
+
+	for _, content := range []string{"Great post!", "Thanks for sharing"} {
+		req := &blogpb.CreateCommentRequest{
+			Comment: &blogpb.Comment{
+				BlogId:   blogID,
+				AuthorId: authorID,
+				Content:  content,
+			},
+		}
+		res, err := c.CreateComment(context.Background(), req)
+		if err != nil {
+			log.Fatalf("Error while calling CreateComment RPC: %v", err)
+		}
+		fmt.Printf("Comment has been created: %v\n", res.GetComment())
+	}
+
+	stream, err := c.ListComments(context.Background(), &blogpb.ListCommentsRequest{BlogId: blogID})
+	if err != nil {
+		log.Fatalf("Error while calling ListComments RPC: %v", err)
+	}
+	for {
+		res, err := stream.Recv()
+		if err == io.EOF {
+			//we've reached the end of the stream
+			break
+		}
+		if err != nil {
+			log.Fatalf("Error while reading the stream: %v", err)
+		}
+		fmt.Printf("Comment: %v\n", res.GetComment().GetContent())
+	}
+}
+
+func doListBlogs(c blogpb.BlogServiceClient) {
+
+	fmt.Println("Listing the blogs...")
+
+	//we ask for small pages, to see the cursor working
+	cursor := ""
+	for page := 1; ; page++ {
+		req := &blogpb.ListBlogsRequest{
+			PageSize: 2,
+			Cursor:   cursor,
+		}
+		stream, err := c.ListBlogs(context.Background(), req)
+		if err != nil {
+			log.Fatalf("Error while calling ListBlogs RPC: %v", err)
+		}
+
+		received := 0
+		for {
+			res, err := stream.Recv()
+			if err == io.EOF {
+				//we've reached the end of the page
+				break
+			}
+			if err != nil {
+				log.Fatalf("Error while reading the stream: %v", err)
+			}
+			fmt.Printf("Page %v: %v\n", page, res.GetBlog())
+			cursor = res.GetCursor()
+			received++
+		}
+
+		if received < int(req.GetPageSize()) {
+			break //it was the last page
+		}
+	}
+}
+
+func doListTags(c blogpb.BlogServiceClient) {
+
+	fmt.Println("Listing the tags...")
+
+	res, err := c.ListTags(context.Background(), &blogpb.ListTagsRequest{Category: "Programming"})
+	if err != nil {
+		log.Fatalf("Error while calling ListTags RPC: %v", err)
+	}
+	for _, tag := range res.GetTags() {
+		fmt.Printf("%v: %v blogs\n", tag.GetTag(), tag.GetCount())
+	}
+}
+
+func doListBlogsByTags(c blogpb.BlogServiceClient, match blogpb.TagMatch, tags ...string) {
+
+	fmt.Printf("Listing the blogs with %v of the tags %v...\n", match, tags)
+
+	req := &blogpb.ListBlogsRequest{
+		Tags:     tags,
+		TagMatch: match,
+		Category: "Programming",
+		ReadMask: &fieldmaskpb.FieldMask{Paths: []string{"tags"}}, //we only print the tags
+	}
+	stream, err := c.ListBlogs(context.Background(), req)
+	if err != nil {
+		log.Fatalf("Error while calling ListBlogs RPC: %v", err)
+	}
+	for {
+		res, err := stream.Recv()
+		if err == io.EOF {
+			//we've reached the end of the stream
+			break
+		}
+		if err != nil {
+			log.Fatalf("Error while reading the stream: %v", err)
+		}
+		fmt.Printf("%v: %v\n", res.GetBlog().GetId(), res.GetBlog().GetTags())
+	}
+}
+
+func doSearchBlogs(c blogpb.BlogServiceClient, query string) {
+
+	fmt.Println("Searching the blogs...")
+
+	res, err := c.SearchBlogs(context.Background(), &blogpb.SearchBlogsRequest{Query: query})
+	if err != nil {
+		log.Fatalf("Error while calling SearchBlogs RPC: %v", err)
+	}
+	for _, result := range res.GetResults() {
+		fmt.Printf("[%.2f] %v: %v\n", result.GetScore(), result.GetTitleHighlight(), result.GetSnippet())
+	}
+}
+
+func doRenderBlog(c blogpb.BlogServiceClient, authorID string) {
+
+	fmt.Println("Rendering a markdown blog...")
+
+	req := &blogpb.CreateBlogRequest{
+		Blog: &blogpb.Blog{
+			AuthorId: authorID,
+			Title:    "Markdown Blog",
+			Content: "# Getting started\n\nThis is **bold**, *italic* and `code`, with a [link](https://grpc.io).\n\n" +
+				"## Install\n\n- first step\n- second step\n\n```go\nfmt.Println(\"<hello>\")\n```\n\n" +
+				"<script>alert('the html is escaped')</script>",
+		},
+	}
+	created, err := c.CreateBlog(context.Background(), req)
+	if err != nil {
+		log.Fatalf("Error while calling CreateBlog RPC: %v", err)
+	}
+
+	res, err := c.RenderBlog(context.Background(), &blogpb.RenderBlogRequest{BlogId: created.GetBlog().GetId()})
+	if err != nil {
+		log.Fatalf("Error while calling RenderBlog RPC: %v", err)
+	}
+	for _, entry := range res.GetToc() {
+		fmt.Printf("%v%v (#%v)\n", strings.Repeat("  ", int(entry.GetLevel()-1)), entry.GetTitle(), entry.GetAnchor())
+	}
+	fmt.Printf("Excerpt: %v\n", res.GetExcerpt())
+	fmt.Printf("Html:\n%v", res.GetHtml())
+}
+
+func doWatchBlogs(c blogpb.BlogServiceClient, timeout time.Duration) {
+
+	fmt.Println("Watching the blogs...")
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	//if the stream breaks, we reconnect sending the last token, so we don't miss any event
+	resumeToken := ""
+	for {
+		stream, err := c.WatchBlogs(ctx, &blogpb.WatchBlogsRequest{ResumeToken: resumeToken})
+		if err != nil {
+			log.Fatalf("Error while calling WatchBlogs RPC: %v", err)
+		}
+
+		for {
+			res, err := stream.Recv()
+			if err != nil {
+				statusErr, _ := status.FromError(err)
+				switch statusErr.Code() {
+				case codes.DeadlineExceeded:
+					fmt.Println("Watch finished!")
+					return
+				case codes.ResourceExhausted, codes.Unavailable:
+					fmt.Println("Watch interrupted, reconnecting: ", statusErr.Message())
+				default:
+					log.Fatalf("Error while reading the stream: %v", err)
+				}
+				break
+			}
+			fmt.Printf("%v: %v\n", res.GetType(), res.GetBlog())
+			resumeToken = res.GetResumeToken()
+		}
+	}
+}
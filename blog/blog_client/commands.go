@@ -0,0 +1,525 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/signal"
+	"strings"
+
+	"github.com/diegoclair/grpc-go-course/blog/blogpb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// The client is a command line tool, like:
+//	blog_client -address localhost:50051 -output json list -author-id 5fd0... -tags go,grpc
+// The global flags come before the command, and the flags of the command can be before or after its arguments.
+
+// command is a subcommand of the client, args are the ones after its name
+type command struct {
+	name  string
+	usage string
+	help  string
+	run   func(c blogpb.BlogServiceClient, args []string) error
+}
+
+var commands []command
+
+func init() {
+	//the commands are filled in init, because their run functions look for them, that would be an initialization loop
+	commands = []command{
+		{"create", "create -author-id <id> -title <title> [-content <text> | -content-file <path>] [-tags a,b] [-category <name>]", "creates a blog", runCreate},
+		{"get", "get [-fields title,tags] <blog id>", "prints a blog", runGet},
+		{"update", "update [-title <title>] [-content <text> | -content-file <path>] [-tags a,b] [-category <name>] [-author-id <id>] <blog id>", "changes only the fields of the flags that are sent", runUpdate},
+		{"delete", "delete [-permanent] [-cascade] <blog id>", "moves a blog to the trash, or removes it with -permanent", runDelete},
+		{"list", "list [-author-id <id>] [-category <name>] [-tags a,b] [-any-tag] [-status <status>] [-limit <n>] [-fields title,tags]", "lists the blogs ordered by id, following all the pages", runList},
+		{"search", "search [-author-id <id>] [-limit <n>] <query>", "searches the blogs by the words of their title and content", runSearch},
+		{"watch", "watch [-author-id <id>] [-resume-token <token>]", "prints the changes of the blogs until Ctrl+C", runWatch},
+		{"import", "import [-format jsonl|binary] <file>", "imports the blogs of a file, with the authors of the file next to it", runImport},
+		{"export", "export [-format jsonl|binary] <file>", "exports all the blogs to a file and their authors to a file next to it", runExport},
+		{"cache-stats", "cache-stats", "prints the hits and misses of the cache of the server", runCacheStats},
+		{"demo", "demo", "calls all the rpcs with example data", runDemo},
+	}
+}
+
+func findCommand(name string) (command, bool) {
+	for _, cmd := range commands {
+		if cmd.name == name {
+			return cmd, true
+		}
+	}
+	return command{}, false
+}
+
+func usage() {
+	out := flag.CommandLine.Output()
+	fmt.Fprintf(out, "Usage: blog_client [flags] <command> [command flags] [arguments]\n\nCommands:\n")
+	for _, cmd := range commands {
+		fmt.Fprintf(out, "  %v\n        %v\n", cmd.usage, cmd.help)
+	}
+	fmt.Fprintf(out, "\nFlags:\n")
+	flag.PrintDefaults()
+}
+
+// parseCommand parses the flags of the command, that can be mixed with its arguments, and returns the arguments
+func parseCommand(fs *flag.FlagSet, args []string, minArgs, maxArgs int) ([]string, error) {
+
+	positional := make([]string, 0)
+	for {
+		err := fs.Parse(args)
+		if err != nil {
+			return nil, err
+		}
+		args = fs.Args()
+		if len(args) == 0 {
+			break
+		}
+		positional = append(positional, args[0])
+		args = args[1:]
+	}
+
+	if len(positional) < minArgs || len(positional) > maxArgs {
+		return nil, fmt.Errorf("wrong number of arguments, usage: %v", fs.Name())
+	}
+	return positional, nil
+}
+
+func newFlagSet(name string) *flag.FlagSet {
+	cmd, _ := findCommand(name)
+	fs := flag.NewFlagSet(cmd.usage, flag.ExitOnError)
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: blog_client [flags] %v\n", cmd.usage)
+		fs.PrintDefaults()
+	}
+	return fs
+}
+
+func requestContext() (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.Background(), *timeout)
+}
+
+// blogFlags are the flags of the Blog fields used by create and update
+type blogFlags struct {
+	authorID    *string
+	title       *string
+	content     *string
+	contentFile *string
+	tags        *string
+	category    *string
+}
+
+func newBlogFlags(fs *flag.FlagSet) blogFlags {
+	return blogFlags{
+		authorID:    fs.String("author-id", "", "id of the author of the blog"),
+		title:       fs.String("title", "", "title of the blog"),
+		content:     fs.String("content", "", "content of the blog, in markdown"),
+		contentFile: fs.String("content-file", "", "file with the content of the blog, - reads it from the stdin"),
+		tags:        fs.String("tags", "", "tags of the blog separated by commas"),
+		category:    fs.String("category", "", "category of the blog"),
+	}
+}
+
+// blog returns the blog of the flags and the update mask with the fields of the flags that were sent
+func (f blogFlags) blog(fs *flag.FlagSet) (*blogpb.Blog, []string, error) {
+
+	blog := &blogpb.Blog{
+		AuthorId: *f.authorID,
+		Title:    *f.title,
+		Content:  *f.content,
+		Tags:     splitList(*f.tags),
+		Category: *f.category,
+	}
+
+	paths := make([]string, 0)
+	var err error
+	fs.Visit(func(fl *flag.Flag) {
+		switch fl.Name {
+		case "author-id", "title", "content", "tags", "category":
+			paths = append(paths, strings.Replace(fl.Name, "-", "_", 1))
+		case "content-file":
+			paths = append(paths, "content")
+			blog.Content, err = readContent(*f.contentFile)
+		}
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return blog, paths, nil
+}
+
+func readContent(path string) (string, error) {
+	var b []byte
+	var err error
+	if path == "-" {
+		b, err = ioutil.ReadAll(os.Stdin)
+	} else {
+		b, err = ioutil.ReadFile(path)
+	}
+	if err != nil {
+		return "", fmt.Errorf("error while reading the content: %w", err)
+	}
+	return string(b), nil
+}
+
+func splitList(value string) []string {
+	items := make([]string, 0)
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+func readMask(fields string) *fieldmaskpb.FieldMask {
+	if fields == "" {
+		return nil
+	}
+	return &fieldmaskpb.FieldMask{Paths: splitList(fields)}
+}
+
+func runCreate(c blogpb.BlogServiceClient, args []string) error {
+
+	fs := newFlagSet("create")
+	fields := newBlogFlags(fs)
+	blogID := fs.String("id", "", "id of the blog, by default the server creates one")
+	key := fs.String("idempotency-key", "", "key sent with the request, so it can be retried without creating the blog twice")
+	_, err := parseCommand(fs, args, 0, 0)
+	if err != nil {
+		return err
+	}
+	blog, _, err := fields.blog(fs)
+	if err != nil {
+		return err
+	}
+	blog.Id = *blogID
+
+	ctx, cancel := requestContext()
+	defer cancel()
+	if *key != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "idempotency-key", *key)
+	}
+	res, err := c.CreateBlog(ctx, &blogpb.CreateBlogRequest{Blog: blog})
+	if err != nil {
+		return err
+	}
+
+	return printBlog(res.GetBlog())
+}
+
+func runGet(c blogpb.BlogServiceClient, args []string) error {
+
+	fs := newFlagSet("get")
+	fields := fs.String("fields", "", "Blog fields to print separated by commas, like title,tags")
+	ids, err := parseCommand(fs, args, 1, 1)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := requestContext()
+	defer cancel()
+	res, err := c.ReadBlog(ctx, &blogpb.ReadBlogRequest{BlogId: ids[0], ReadMask: readMask(*fields)})
+	if err != nil {
+		return err
+	}
+
+	return printBlog(res.GetBlog())
+}
+
+func runUpdate(c blogpb.BlogServiceClient, args []string) error {
+
+	fs := newFlagSet("update")
+	fields := newBlogFlags(fs)
+	expectedRevision := fs.Int64("expected-revision", 0, "revision that the blog must have, by default the current one is read before the update")
+	ids, err := parseCommand(fs, args, 1, 1)
+	if err != nil {
+		return err
+	}
+	blog, paths, err := fields.blog(fs)
+	if err != nil {
+		return err
+	}
+	if len(paths) == 0 {
+		return errors.New("there is nothing to update, send the flags of the fields to change")
+	}
+	blog.Id = ids[0]
+
+	ctx, cancel := requestContext()
+	defer cancel()
+	revision := *expectedRevision
+	if revision == 0 {
+		current, err := c.ReadBlog(ctx, &blogpb.ReadBlogRequest{
+			BlogId:   blog.GetId(),
+			ReadMask: &fieldmaskpb.FieldMask{Paths: []string{"revision"}},
+		})
+		if err != nil {
+			return err
+		}
+		revision = current.GetBlog().GetRevision()
+	}
+
+	res, err := c.UpdateBlog(ctx, &blogpb.UpdateBlogRequest{
+		Blog:             blog,
+		ExpectedRevision: revision,
+		UpdateMask:       &fieldmaskpb.FieldMask{Paths: paths},
+	})
+	if err != nil {
+		return err
+	}
+
+	return printBlog(res.GetBlog())
+}
+
+func runDelete(c blogpb.BlogServiceClient, args []string) error {
+
+	fs := newFlagSet("delete")
+	permanent := fs.Bool("permanent", false, "removes the blog instead of moving it to the trash")
+	cascade := fs.Bool("cascade", false, "also removes the comments of the blog, only used with -permanent")
+	ids, err := parseCommand(fs, args, 1, 1)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := requestContext()
+	defer cancel()
+	res, err := c.DeleteBlog(ctx, &blogpb.DeleteBlogRequest{BlogId: ids[0], Permanent: *permanent, Cascade: *cascade})
+	if err != nil {
+		return err
+	}
+
+	if *output == outputJSON {
+		return printJSON(res)
+	}
+	if *permanent {
+		fmt.Printf("Blog %v was deleted\n", res.GetBlogId())
+	} else {
+		fmt.Printf("Blog %v was moved to the trash\n", res.GetBlogId())
+	}
+	return nil
+}
+
+func runList(c blogpb.BlogServiceClient, args []string) error {
+
+	fs := newFlagSet("list")
+	authorID := fs.String("author-id", "", "only blogs of this author")
+	category := fs.String("category", "", "only blogs of this category")
+	tags := fs.String("tags", "", "only blogs with all these tags, separated by commas")
+	anyTag := fs.Bool("any-tag", false, "the blogs need only one of the -tags")
+	statusName := fs.String("status", "", "only blogs with this status: draft, in_review, scheduled, published or archived")
+	limit := fs.Int("limit", 0, "max number of blogs, 0 lists all of them")
+	pageSize := fs.Int("page-size", 50, "blogs requested in each call, the next pages are requested with the cursor")
+	fields := fs.String("fields", "", "Blog fields to print separated by commas, like title,tags")
+	_, err := parseCommand(fs, args, 0, 0)
+	if err != nil {
+		return err
+	}
+	if *pageSize <= 0 {
+		return errors.New("the -page-size must be positive")
+	}
+
+	req := &blogpb.ListBlogsRequest{
+		AuthorId: *authorID,
+		Category: *category,
+		Tags:     splitList(*tags),
+		ReadMask: readMask(*fields),
+	}
+	if *anyTag {
+		req.TagMatch = blogpb.TagMatch_TAG_MATCH_ANY
+	}
+	if *statusName != "" {
+		value, ok := blogpb.BlogStatus_value["BLOG_STATUS_"+strings.ToUpper(*statusName)]
+		if !ok {
+			return fmt.Errorf("unknown status %q", *statusName)
+		}
+		req.Status = blogpb.BlogStatus(value)
+	}
+
+	table := newBlogTable()
+	listed := 0
+	for {
+		req.PageSize = int32(*pageSize)
+		if *limit > 0 && *limit-listed < *pageSize {
+			req.PageSize = int32(*limit - listed)
+		}
+
+		received, err := listPage(c, req, func(res *blogpb.ListBlogsResponse) error {
+			req.Cursor = res.GetCursor()
+			return table.add(res.GetBlog())
+		})
+		if err != nil {
+			return err
+		}
+		listed += received
+
+		if received < int(req.GetPageSize()) || (*limit > 0 && listed >= *limit) {
+			break
+		}
+	}
+
+	return table.flush()
+}
+
+// listPage calls fn for each blog of one page and returns how many were received
+func listPage(c blogpb.BlogServiceClient, req *blogpb.ListBlogsRequest, fn func(res *blogpb.ListBlogsResponse) error) (int, error) {
+
+	ctx, cancel := requestContext()
+	defer cancel()
+	stream, err := c.ListBlogs(ctx, req)
+	if err != nil {
+		return 0, err
+	}
+
+	received := 0
+	for {
+		res, err := stream.Recv()
+		if err == io.EOF {
+			return received, nil
+		}
+		if err != nil {
+			return received, err
+		}
+		err = fn(res)
+		if err != nil {
+			return received, err
+		}
+		received++
+	}
+}
+
+func runSearch(c blogpb.BlogServiceClient, args []string) error {
+
+	fs := newFlagSet("search")
+	authorID := fs.String("author-id", "", "only blogs of this author")
+	limit := fs.Int("limit", 0, "max number of results, the server default is 20")
+	words, err := parseCommand(fs, args, 1, 1<<10)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := requestContext()
+	defer cancel()
+	res, err := c.SearchBlogs(ctx, &blogpb.SearchBlogsRequest{
+		Query:    strings.Join(words, " "),
+		Limit:    int32(*limit),
+		AuthorId: *authorID,
+	})
+	if err != nil {
+		return err
+	}
+
+	return printSearchResults(res)
+}
+
+func runWatch(c blogpb.BlogServiceClient, args []string) error {
+
+	fs := newFlagSet("watch")
+	authorID := fs.String("author-id", "", "only changes of blogs of this author")
+	resumeToken := fs.String("resume-token", "", "resumes a previous watch after the event of this token")
+	_, err := parseCommand(fs, args, 0, 0)
+	if err != nil {
+		return err
+	}
+
+	//the watch runs until Ctrl+C, then the context is canceled and the stream ends
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ch := make(chan os.Signal, 1)
+	signal.Notify(ch, os.Interrupt)
+	go func() {
+		<-ch
+		cancel()
+	}()
+
+	//if the stream breaks, we reconnect sending the last token, so we don't miss any event
+	req := &blogpb.WatchBlogsRequest{AuthorId: *authorID, ResumeToken: *resumeToken}
+	for {
+		stream, err := c.WatchBlogs(ctx, req)
+		if err != nil {
+			return err
+		}
+
+		for {
+			res, err := stream.Recv()
+			if err != nil {
+				switch status.Code(err) {
+				case codes.Canceled:
+					return nil
+				case codes.ResourceExhausted, codes.Unavailable:
+					fmt.Fprintf(os.Stderr, "Watch interrupted, reconnecting: %v\n", status.Convert(err).Message())
+				default:
+					return err
+				}
+				break
+			}
+			err = printEvent(res)
+			if err != nil {
+				return err
+			}
+			req.ResumeToken = res.GetResumeToken()
+		}
+	}
+}
+
+func runImport(c blogpb.BlogServiceClient, args []string) error {
+
+	fs := newFlagSet("import")
+	format := fs.String("format", formatJSONLines, "format of the file: jsonl or binary")
+	paths, err := parseCommand(fs, args, 1, 1)
+	if err != nil {
+		return err
+	}
+
+	doImportBlogs(c, paths[0], *format)
+	return nil
+}
+
+func runExport(c blogpb.BlogServiceClient, args []string) error {
+
+	fs := newFlagSet("export")
+	format := fs.String("format", formatJSONLines, "format of the file: jsonl or binary")
+	paths, err := parseCommand(fs, args, 1, 1)
+	if err != nil {
+		return err
+	}
+
+	doExportBlogs(c, paths[0], *format)
+	return nil
+}
+
+func runCacheStats(c blogpb.BlogServiceClient, args []string) error {
+
+	fs := newFlagSet("cache-stats")
+	_, err := parseCommand(fs, args, 0, 0)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := requestContext()
+	defer cancel()
+	res, err := c.GetCacheStats(ctx, &blogpb.GetCacheStatsRequest{})
+	if err != nil {
+		return err
+	}
+
+	return printCacheStats(res)
+}
+
+func runDemo(c blogpb.BlogServiceClient, args []string) error {
+
+	fs := newFlagSet("demo")
+	_, err := parseCommand(fs, args, 0, 0)
+	if err != nil {
+		return err
+	}
+
+	doExamples(c)
+	return nil
+}
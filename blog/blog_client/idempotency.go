@@ -0,0 +1,63 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"log"
+
+	"github.com/diegoclair/grpc-go-course/blog/blogpb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// newIdempotencyKey returns a random key, the same key must be sent in all the retries of a request
+func newIdempotencyKey() string {
+	b := make([]byte, 16)
+	_, err := rand.Read(b)
+	if err != nil {
+		log.Fatalf("Error while generating the idempotency key: %v", err)
+	}
+	return hex.EncodeToString(b)
+}
+
+func doRetryCreateBlog(c blogpb.BlogServiceClient, authorID string) {
+
+	fmt.Println("Creating a blog with an idempotency key...")
+
+	key := newIdempotencyKey()
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "idempotency-key", key)
+	req := &blogpb.CreateBlogRequest{
+		Blog: &blogpb.Blog{
+			AuthorId: authorID,
+			Title:    "My Retried Blog",
+			Content:  "This blog is created only once",
+		},
+	}
+
+	first, err := c.CreateBlog(ctx, req)
+	if err != nil {
+		log.Fatalf("Error while calling CreateBlog RPC: %v", err)
+	}
+
+	//like a retry after we lost the response, the server sends the first result again
+	var header metadata.MD
+	retry, err := c.CreateBlog(ctx, req, grpc.Header(&header))
+	if err != nil {
+		log.Fatalf("Error while calling CreateBlog RPC: %v", err)
+	}
+	fmt.Printf("Blog was created once: %v, replayed: %v\n", first.GetBlog().GetId() == retry.GetBlog().GetId(), header.Get("idempotent-replayed"))
+
+	//this one should return INVALID_ARGUMENT, because the key was used with another request
+	req.Blog.Title = "Another Blog"
+	_, err = c.CreateBlog(ctx, req)
+	if err != nil {
+		fmt.Printf("Error happened while creating: %v\n", err)
+	}
+
+	_, err = c.DeleteBlog(context.Background(), &blogpb.DeleteBlogRequest{BlogId: first.GetBlog().GetId(), Permanent: true})
+	if err != nil {
+		log.Fatalf("Error while calling DeleteBlog RPC: %v", err)
+	}
+}
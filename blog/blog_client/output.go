@@ -0,0 +1,200 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/diegoclair/grpc-go-course/blog/blogpb"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// The results are printed as tables for people or as json for scripts. In json each message is
+// printed in a single line, so the lists can be read line by line, like with jq.
+
+const (
+	outputTable = "table"
+	outputJSON  = "json"
+
+	maxTitleWidth = 50
+)
+
+var jsonOptions = protojson.MarshalOptions{UseProtoNames: true}
+
+func printJSON(msg proto.Message) error {
+	b, err := jsonOptions.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(b))
+	return nil
+}
+
+// printBlog prints all the fields of the blog, one per line
+func printBlog(blog *blogpb.Blog) error {
+
+	if *output == outputJSON {
+		return printJSON(blog)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintf(w, "ID:\t%v\n", blog.GetId())
+	fmt.Fprintf(w, "Author:\t%v\n", blog.GetAuthorId())
+	fmt.Fprintf(w, "Title:\t%v\n", blog.GetTitle())
+	fmt.Fprintf(w, "Status:\t%v\n", statusName(blog.GetStatus()))
+	fmt.Fprintf(w, "Revision:\t%v\n", blog.GetRevision())
+	fmt.Fprintf(w, "Category:\t%v\n", blog.GetCategory())
+	fmt.Fprintf(w, "Tags:\t%v\n", strings.Join(blog.GetTags(), ", "))
+	fmt.Fprintf(w, "Created:\t%v\n", formatTime(blog.GetCreatedAt()))
+	fmt.Fprintf(w, "Updated:\t%v\n", formatTime(blog.GetUpdatedAt()))
+	if blog.GetDeletedAt() != nil {
+		fmt.Fprintf(w, "Deleted:\t%v\n", formatTime(blog.GetDeletedAt()))
+	}
+	err := w.Flush()
+	if err != nil {
+		return err
+	}
+
+	if blog.GetContent() != "" {
+		fmt.Printf("\n%v\n", blog.GetContent())
+	}
+	return nil
+}
+
+// blogTable prints one blog per row, the rows are aligned when the table is flushed
+type blogTable struct {
+	w *tabwriter.Writer
+}
+
+func newBlogTable() *blogTable {
+	if *output == outputJSON {
+		return &blogTable{}
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tAUTHOR\tSTATUS\tREV\tUPDATED\tTITLE\tTAGS")
+	return &blogTable{w: w}
+}
+
+func (t *blogTable) add(blog *blogpb.Blog) error {
+	if t.w == nil {
+		return printJSON(blog)
+	}
+	_, err := fmt.Fprintf(t.w, "%v\t%v\t%v\t%v\t%v\t%v\t%v\n", blog.GetId(), blog.GetAuthorId(), statusName(blog.GetStatus()),
+		blog.GetRevision(), formatTime(blog.GetUpdatedAt()), truncate(blog.GetTitle(), maxTitleWidth), strings.Join(blog.GetTags(), ","))
+	return err
+}
+
+func (t *blogTable) flush() error {
+	if t.w == nil {
+		return nil
+	}
+	return t.w.Flush()
+}
+
+func printSearchResults(res *blogpb.SearchBlogsResponse) error {
+
+	if *output == outputJSON {
+		for _, result := range res.GetResults() {
+			err := printJSON(result)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tSCORE\tTITLE\tSNIPPET")
+	for _, result := range res.GetResults() {
+		fmt.Fprintf(w, "%v\t%.2f\t%v\t%v\n", result.GetBlog().GetId(), result.GetScore(),
+			truncate(result.GetBlog().GetTitle(), maxTitleWidth), strings.Join(strings.Fields(result.GetSnippet()), " "))
+	}
+	return w.Flush()
+}
+
+// printCacheStats prints the counters of the cache of the server, with the part of the reads that were hits
+func printCacheStats(stats *blogpb.GetCacheStatsResponse) error {
+
+	if *output == outputJSON {
+		return printJSON(stats)
+	}
+	if !stats.GetEnabled() {
+		_, err := fmt.Println("The cache is disabled in the server")
+		return err
+	}
+
+	hitRate := 0.0
+	if reads := stats.GetHits() + stats.GetMisses(); reads > 0 {
+		hitRate = float64(stats.GetHits()) / float64(reads) * 100
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintf(w, "Hits:\t%v (%.1f%%)\n", stats.GetHits(), hitRate)
+	fmt.Fprintf(w, "Misses:\t%v\n", stats.GetMisses())
+	fmt.Fprintf(w, "Evictions:\t%v\n", stats.GetEvictions())
+	fmt.Fprintf(w, "Invalidations:\t%v\n", stats.GetInvalidations())
+	fmt.Fprintf(w, "Entries:\t%v\n", stats.GetEntries())
+	fmt.Fprintf(w, "Size:\t%v of %v bytes\n", stats.GetSizeBytes(), stats.GetMaxSizeBytes())
+	return w.Flush()
+}
+
+// printEvent prints the event right away, the watch doesn't know when the next one comes to align them
+func printEvent(event *blogpb.WatchBlogsResponse) error {
+
+	if *output == outputJSON {
+		return printJSON(event)
+	}
+
+	eventType := strings.TrimPrefix(event.GetType().String(), "BLOG_EVENT_TYPE_")
+	_, err := fmt.Printf("%v  %-8v %v  %v\n", formatTime(event.GetEventTime()), eventType, event.GetBlog().GetId(), event.GetBlog().GetTitle())
+	return err
+}
+
+// printError prints the message of the error, with the invalid fields when the server returns them
+func printError(err error) {
+
+	st, ok := status.FromError(err)
+	if !ok {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return
+	}
+
+	fmt.Fprintf(os.Stderr, "Error: %v: %v\n", st.Code(), st.Message())
+	for _, detail := range st.Details() {
+		switch d := detail.(type) {
+		case *errdetails.BadRequest:
+			for _, violation := range d.GetFieldViolations() {
+				fmt.Fprintf(os.Stderr, "  %v: %v\n", violation.GetField(), violation.GetDescription())
+			}
+		case *errdetails.ErrorInfo:
+			for key, value := range d.GetMetadata() {
+				fmt.Fprintf(os.Stderr, "  %v: %v\n", key, value)
+			}
+		}
+	}
+}
+
+func statusName(s blogpb.BlogStatus) string {
+	return strings.ToLower(strings.TrimPrefix(s.String(), "BLOG_STATUS_"))
+}
+
+func formatTime(t *timestamppb.Timestamp) string {
+	if t == nil {
+		return ""
+	}
+	return t.AsTime().Local().Format(time.RFC3339)
+}
+
+// truncate cuts the text to max runes, so a long title doesn't break the table
+func truncate(text string, max int) string {
+	runes := []rune(text)
+	if len(runes) <= max {
+		return text
+	}
+	return string(runes[:max-3]) + "..."
+}
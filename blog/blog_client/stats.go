@@ -0,0 +1,111 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"time"
+
+	"github.com/diegoclair/grpc-go-course/blog/blogpb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func doStats(c blogpb.BlogServiceClient, authorID string) {
+
+	fmt.Println("Publishing the blogs and recording some views...")
+
+	stream, err := c.ListBlogs(context.Background(), &blogpb.ListBlogsRequest{
+		AuthorId: authorID,
+		ReadMask: &fieldmaskpb.FieldMask{Paths: []string{"title"}},
+	})
+	if err != nil {
+		log.Fatalf("Error while calling ListBlogs RPC: %v", err)
+	}
+	blogIDs := make([]string, 0)
+	for {
+		res, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			log.Fatalf("Error while reading the stream: %v", err)
+		}
+		blogIDs = append(blogIDs, res.GetBlog().GetId())
+	}
+
+	//the stats only count the published blogs, the new ones are drafts
+	for _, blogID := range blogIDs {
+		_, err := c.SubmitBlogForReview(context.Background(), &blogpb.SubmitBlogForReviewRequest{BlogId: blogID})
+		if err != nil {
+			log.Fatalf("Error while calling SubmitBlogForReview RPC: %v", err)
+		}
+		_, err = c.PublishBlog(context.Background(), &blogpb.PublishBlogRequest{BlogId: blogID})
+		if err != nil {
+			log.Fatalf("Error while calling PublishBlog RPC: %v", err)
+		}
+	}
+
+	//each blog gets one view more than the previous one, so they have different positions in the ranking
+	for i, blogID := range blogIDs {
+		for view := 0; view <= i; view++ {
+			_, err := c.RecordView(context.Background(), &blogpb.RecordViewRequest{BlogId: blogID})
+			if err != nil {
+				log.Fatalf("Error while calling RecordView RPC: %v", err)
+			}
+		}
+	}
+
+	fmt.Println("Getting the stats...")
+
+	authors, err := c.GetAuthorStats(context.Background(), &blogpb.GetAuthorStatsRequest{AuthorId: authorID})
+	if err != nil {
+		log.Fatalf("Error while calling GetAuthorStats RPC: %v", err)
+	}
+	for _, author := range authors.GetAuthors() {
+		fmt.Printf("Author %v: %v blogs, %v words, %v views\n", author.GetAuthorId(), author.GetBlogCount(), author.GetWordCount(), author.GetViewCount())
+	}
+
+	//the server uses UTC when the time_zone is not sent
+	now := time.Now().UTC()
+	days, err := c.GetPostsPerDay(context.Background(), &blogpb.GetPostsPerDayRequest{
+		StartDate: now.AddDate(0, 0, -6).Format("2006-01-02"),
+		EndDate:   now.Format("2006-01-02"),
+	})
+	if err != nil {
+		log.Fatalf("Error while calling GetPostsPerDay RPC: %v", err)
+	}
+	for _, day := range days.GetDays() {
+		fmt.Printf("%v: %v blogs\n", day.GetDate(), day.GetCount())
+	}
+
+	viewed, err := c.ListMostViewedBlogs(context.Background(), &blogpb.ListMostViewedBlogsRequest{
+		Limit:    2,
+		ReadMask: &fieldmaskpb.FieldMask{Paths: []string{"title"}},
+	})
+	if err != nil {
+		log.Fatalf("Error while calling ListMostViewedBlogs RPC: %v", err)
+	}
+	for _, blog := range viewed.GetBlogs() {
+		fmt.Printf("%v views: %v\n", blog.GetViews(), blog.GetBlog())
+	}
+}
+
+func doCacheStats(c blogpb.BlogServiceClient, blogID string) {
+
+	fmt.Println("Reading a popular blog...")
+
+	//only the first read goes to the storage, the others are hits of the cache until the blog is written
+	for i := 0; i < 3; i++ {
+		_, err := c.ReadBlog(context.Background(), &blogpb.ReadBlogRequest{BlogId: blogID})
+		if err != nil {
+			log.Fatalf("Error while calling ReadBlog RPC: %v", err)
+		}
+	}
+
+	stats, err := c.GetCacheStats(context.Background(), &blogpb.GetCacheStatsRequest{})
+	if err != nil {
+		log.Fatalf("Error while calling GetCacheStats RPC: %v", err)
+	}
+	fmt.Printf("Cache: %v hits, %v misses, %v entries with %v bytes\n", stats.GetHits(), stats.GetMisses(), stats.GetEntries(), stats.GetSizeBytes())
+}
@@ -0,0 +1,300 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/diegoclair/grpc-go-course/blog/blogpb"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// The blogs can be saved in two formats:
+//	jsonl: one blog per line, encoded with protojson
+//	binary: each blog is encoded with protobuf and prefixed by its size as a varint (the same of writeDelimitedTo in java)
+//
+// The blogs need their authors in the server, so the export also writes the authors to a file next to the blogs,
+// in the same format, like blogs.authors.jsonl for blogs.jsonl. The import sends that file first, when it exists.
+
+const (
+	formatJSONLines = "jsonl"
+	formatBinary    = "binary"
+
+	maxRecordSize = 64 << 20
+)
+
+// errCorruptedFile is returned when the file can't be read anymore, the other read errors only affect one record
+var errCorruptedFile = errors.New("the file is corrupted")
+
+// recordReader reads the blogs or the authors of a file into m, a bad record is returned as an error but doesn't stop the next reads
+type recordReader interface {
+	Read(m proto.Message) error
+}
+
+type recordWriter interface {
+	Write(m proto.Message) error
+	Flush() error
+}
+
+func newRecordReader(r io.Reader, format string) (recordReader, error) {
+	switch format {
+	case formatJSONLines:
+		scanner := bufio.NewScanner(r)
+		scanner.Buffer(make([]byte, 64*1024), maxRecordSize)
+		return &jsonLinesReader{scanner: scanner}, nil
+	case formatBinary:
+		return &binaryReader{reader: bufio.NewReader(r)}, nil
+	}
+	return nil, fmt.Errorf("unknown format %q, it should be %v or %v", format, formatJSONLines, formatBinary)
+}
+
+func newRecordWriter(w io.Writer, format string) (recordWriter, error) {
+	switch format {
+	case formatJSONLines:
+		return &jsonLinesWriter{writer: bufio.NewWriter(w)}, nil
+	case formatBinary:
+		return &binaryWriter{writer: bufio.NewWriter(w)}, nil
+	}
+	return nil, fmt.Errorf("unknown format %q, it should be %v or %v", format, formatJSONLines, formatBinary)
+}
+
+type jsonLinesReader struct {
+	scanner *bufio.Scanner
+}
+
+func (r *jsonLinesReader) Read(m proto.Message) error {
+	for r.scanner.Scan() {
+		line := bytes.TrimSpace(r.scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		return protojson.Unmarshal(line, m)
+	}
+
+	if err := r.scanner.Err(); err != nil {
+		return fmt.Errorf("%w: %v", errCorruptedFile, err)
+	}
+	return io.EOF
+}
+
+type jsonLinesWriter struct {
+	writer *bufio.Writer
+}
+
+func (w *jsonLinesWriter) Write(m proto.Message) error {
+	b, err := protojson.Marshal(m)
+	if err != nil {
+		return err
+	}
+	_, err = w.writer.Write(append(b, '\n'))
+	return err
+}
+
+func (w *jsonLinesWriter) Flush() error {
+	return w.writer.Flush()
+}
+
+type binaryReader struct {
+	reader *bufio.Reader
+}
+
+func (r *binaryReader) Read(m proto.Message) error {
+
+	size, err := binary.ReadUvarint(r.reader)
+	if err == io.EOF {
+		return io.EOF
+	}
+	if err != nil {
+		return fmt.Errorf("%w, could not read the record size: %v", errCorruptedFile, err)
+	}
+	if size > maxRecordSize {
+		return fmt.Errorf("%w, the record size %v is bigger than the limit of %v bytes", errCorruptedFile, size, maxRecordSize)
+	}
+
+	b := make([]byte, size)
+	_, err = io.ReadFull(r.reader, b)
+	if err != nil {
+		return fmt.Errorf("%w, could not read the record: %v", errCorruptedFile, err)
+	}
+
+	//if the message is broken we still have read all its bytes, so the next read starts in the next record
+	return proto.Unmarshal(b, m)
+}
+
+type binaryWriter struct {
+	writer *bufio.Writer
+}
+
+func (w *binaryWriter) Write(m proto.Message) error {
+	b, err := proto.Marshal(m)
+	if err != nil {
+		return err
+	}
+
+	size := make([]byte, binary.MaxVarintLen64)
+	n := binary.PutUvarint(size, uint64(len(b)))
+	_, err = w.writer.Write(size[:n])
+	if err != nil {
+		return err
+	}
+	_, err = w.writer.Write(b)
+	return err
+}
+
+func (w *binaryWriter) Flush() error {
+	return w.writer.Flush()
+}
+
+// authorsPath returns the file of the authors of a blogs file, like blogs.authors.jsonl for blogs.jsonl
+func authorsPath(path string) string {
+	ext := filepath.Ext(path)
+	return strings.TrimSuffix(path, ext) + ".authors" + ext
+}
+
+func doImportBlogs(c blogpb.BlogServiceClient, path, format string) {
+
+	stream, err := c.ImportBlogs(context.Background())
+	if err != nil {
+		log.Fatalf("Error while calling ImportBlogs RPC: %v", err)
+	}
+
+	//the authors are sent first, the blogs of an unknown author are not imported
+	badRecords := 0
+	if _, err := os.Stat(authorsPath(path)); err == nil {
+		badRecords += sendRecords(stream, authorsPath(path), format, func() proto.Message {
+			return &blogpb.Author{}
+		}, func(m proto.Message) *blogpb.ImportBlogsRequest {
+			return &blogpb.ImportBlogsRequest{Record: &blogpb.ImportBlogsRequest_Author{Author: m.(*blogpb.Author)}}
+		})
+	}
+	badRecords += sendRecords(stream, path, format, func() proto.Message {
+		return &blogpb.Blog{}
+	}, func(m proto.Message) *blogpb.ImportBlogsRequest {
+		return &blogpb.ImportBlogsRequest{Record: &blogpb.ImportBlogsRequest_Blog{Blog: m.(*blogpb.Blog)}}
+	})
+
+	res, err := stream.CloseAndRecv()
+	if err != nil {
+		log.Fatalf("Error while receiving response for ImportBlogs: %v", err)
+	}
+	for _, importErr := range res.GetErrors() {
+		if importErr.GetAuthorId() != "" {
+			fmt.Printf("Record %v, author %v, was not imported: %v %v\n", importErr.GetIndex(), importErr.GetAuthorId(), importErr.GetCode(), importErr.GetMessage())
+			continue
+		}
+		fmt.Printf("Record %v, blog %v, was not imported: %v %v\n", importErr.GetIndex(), importErr.GetBlogId(), importErr.GetCode(), importErr.GetMessage())
+	}
+	fmt.Printf("Imported: %v, authors: %v, failed: %v, bad records: %v\n", res.GetImported(), res.GetImportedAuthors(), res.GetFailed(), badRecords)
+}
+
+// sendRecords sends each record of the file to the import and returns how many records could not be read
+func sendRecords(stream blogpb.BlogService_ImportBlogsClient, path, format string, newRecord func() proto.Message, newRequest func(m proto.Message) *blogpb.ImportBlogsRequest) int {
+
+	fmt.Printf("Importing the records of %v...\n", path)
+
+	file, err := os.Open(path)
+	if err != nil {
+		log.Fatalf("Error while opening the import file: %v", err)
+	}
+	defer file.Close()
+
+	reader, err := newRecordReader(file, format)
+	if err != nil {
+		log.Fatalf("Error while reading the import file: %v", err)
+	}
+
+	badRecords := 0
+	for record := 1; ; record++ {
+		m := newRecord()
+		err := reader.Read(m)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			//a bad record doesn't stop the import, unless we can't find where the next one starts
+			fmt.Printf("Record %v of %v was skipped: %v\n", record, path, err)
+			badRecords++
+			if errors.Is(err, errCorruptedFile) {
+				break
+			}
+			continue
+		}
+
+		err = stream.Send(newRequest(m))
+		if err != nil {
+			log.Fatalf("Error while sending streaming data to server: %v", err)
+		}
+	}
+
+	return badRecords
+}
+
+func doExportBlogs(c blogpb.BlogServiceClient, path, format string) {
+
+	fmt.Printf("Exporting the blogs to %v and their authors to %v...\n", path, authorsPath(path))
+
+	blogs, blogsFile := createExportFile(path, format)
+	defer blogsFile.Close()
+	authors, authorsFile := createExportFile(authorsPath(path), format)
+	defer authorsFile.Close()
+
+	stream, err := c.ExportBlogs(context.Background(), &blogpb.ExportBlogsRequest{})
+	if err != nil {
+		log.Fatalf("Error while calling ExportBlogs RPC: %v", err)
+	}
+
+	exportedBlogs, exportedAuthors := 0, 0
+	for {
+		res, err := stream.Recv()
+		if err == io.EOF {
+			//we've reached the end of the stream
+			break
+		}
+		if err != nil {
+			log.Fatalf("Error while reading the stream: %v", err)
+		}
+
+		switch record := res.GetRecord().(type) {
+		case *blogpb.ExportBlogsResponse_Author:
+			err = authors.Write(record.Author)
+			exportedAuthors++
+		case *blogpb.ExportBlogsResponse_Blog:
+			err = blogs.Write(record.Blog)
+			exportedBlogs++
+		}
+		if err != nil {
+			log.Fatalf("Error while writing the export file: %v", err)
+		}
+	}
+
+	for _, writer := range []recordWriter{blogs, authors} {
+		err = writer.Flush()
+		if err != nil {
+			log.Fatalf("Error while writing the export file: %v", err)
+		}
+	}
+	fmt.Printf("Exported %v blogs and %v authors\n", exportedBlogs, exportedAuthors)
+}
+
+func createExportFile(path, format string) (recordWriter, *os.File) {
+
+	file, err := os.Create(path)
+	if err != nil {
+		log.Fatalf("Error while creating the export file: %v", err)
+	}
+
+	writer, err := newRecordWriter(file, format)
+	if err != nil {
+		log.Fatalf("Error while writing the export file: %v", err)
+	}
+	return writer, file
+}
@@ -0,0 +1,359 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/diegoclair/grpc-go-course/blog/blogpb"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// The attachments are saved in a directory of the local disk, each one in two files:
+//	<id>: the content of the file
+//	<id>.json: the Attachment message, it is written after the content, so it only exists for a complete upload
+// While it is being uploaded the content is in <id>.part, these files are removed when the server starts.
+
+const (
+	partSuffix     = ".part"
+	metadataSuffix = ".json"
+	sniffSize      = 512 //bytes used by http.DetectContentType
+)
+
+var (
+	errAttachmentNotFound   = errors.New("attachment not found")
+	errAttachmentTooLarge   = errors.New("attachment is too large")
+	errChecksumMismatch     = errors.New("attachment checksum mismatch")
+	errContentTypeMismatch  = errors.New("attachment content doesn't match its content type")
+	errContentTypeForbidden = errors.New("attachment content type is not allowed")
+)
+
+// allowedContentTypes are the content types accepted in the uploads
+var allowedContentTypes = map[string]bool{
+	"image/png":       true,
+	"image/jpeg":      true,
+	"image/gif":       true,
+	"image/webp":      true,
+	"application/pdf": true,
+	"text/plain":      true,
+	"text/markdown":   true,
+	"text/csv":        true,
+}
+
+type attachmentStore struct {
+	mu          sync.RWMutex
+	dir         string
+	maxSize     int64
+	attachments map[string]*blogpb.Attachment //id -> attachment, loaded from the metadata files
+}
+
+// attachmentWriter receives the content of a new attachment, it must be finished with commit or abort
+type attachmentWriter struct {
+	store      *attachmentStore
+	attachment *blogpb.Attachment
+	file       *os.File
+	hash       hash.Hash
+	head       []byte //first bytes of the content, used to check the content type
+}
+
+func newAttachmentStore(dir string, maxSize int64) (*attachmentStore, error) {
+
+	err := os.MkdirAll(dir, 0755)
+	if err != nil {
+		return nil, err
+	}
+
+	a := &attachmentStore{
+		dir:         dir,
+		maxSize:     maxSize,
+		attachments: make(map[string]*blogpb.Attachment),
+	}
+	err = a.load()
+	if err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
+
+// load reads the metadata files and removes the files of the uploads that didn't finish
+func (a *attachmentStore) load() error {
+
+	files, err := ioutil.ReadDir(a.dir)
+	if err != nil {
+		return err
+	}
+
+	contents := make(map[string]bool)
+	for _, file := range files {
+		name := file.Name()
+		switch {
+		case strings.HasSuffix(name, partSuffix):
+			os.Remove(filepath.Join(a.dir, name))
+		case strings.HasSuffix(name, metadataSuffix):
+			b, err := ioutil.ReadFile(filepath.Join(a.dir, name))
+			if err != nil {
+				return err
+			}
+			attachment := &blogpb.Attachment{}
+			err = protojson.Unmarshal(b, attachment)
+			if err != nil {
+				return err
+			}
+			a.attachments[attachment.GetId()] = attachment
+		default:
+			contents[name] = true
+		}
+	}
+
+	//a content without metadata is from an upload that stopped between the two files
+	for name := range contents {
+		if _, ok := a.attachments[name]; !ok {
+			os.Remove(filepath.Join(a.dir, name))
+		}
+	}
+
+	return nil
+}
+
+// create starts a new attachment, the metadata must already be validated
+func (a *attachmentStore) create(attachment *blogpb.Attachment) (*attachmentWriter, error) {
+
+	if !allowedContentTypes[attachment.GetContentType()] {
+		return nil, errContentTypeForbidden
+	}
+	if attachment.GetSize() > a.maxSize {
+		return nil, a.tooLarge()
+	}
+
+	file, err := os.OpenFile(a.path(attachment.GetId())+partSuffix, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, err
+	}
+
+	//the size sent by the client is only used to check the limit, the writer counts the received bytes
+	data := proto.Clone(attachment).(*blogpb.Attachment)
+	data.Size = 0
+
+	return &attachmentWriter{
+		store:      a,
+		attachment: data,
+		file:       file,
+		hash:       sha256.New(),
+	}, nil
+}
+
+func (w *attachmentWriter) write(chunk []byte) error {
+
+	if w.attachment.GetSize()+int64(len(chunk)) > w.store.maxSize {
+		return w.store.tooLarge()
+	}
+	if len(w.head) < sniffSize {
+		n := sniffSize - len(w.head)
+		if n > len(chunk) {
+			n = len(chunk)
+		}
+		w.head = append(w.head, chunk[:n]...)
+	}
+
+	_, err := w.file.Write(chunk)
+	if err != nil {
+		return err
+	}
+	w.hash.Write(chunk)
+	w.attachment.Size += int64(len(chunk))
+
+	return nil
+}
+
+// commit checks the content and saves the attachment, if it fails the upload is aborted
+func (w *attachmentWriter) commit(checksum string) (*blogpb.Attachment, error) {
+
+	err := w.finish(checksum)
+	if err != nil {
+		w.abort()
+		return nil, err
+	}
+	return proto.Clone(w.attachment).(*blogpb.Attachment), nil
+}
+
+func (w *attachmentWriter) finish(checksum string) error {
+
+	if !contentTypeMatches(w.attachment.GetContentType(), w.head) {
+		return errContentTypeMismatch
+	}
+	w.attachment.Sha256 = hex.EncodeToString(w.hash.Sum(nil))
+	if !strings.EqualFold(checksum, w.attachment.GetSha256()) {
+		return errChecksumMismatch
+	}
+
+	err := w.file.Sync()
+	if err != nil {
+		return err
+	}
+	err = w.file.Close()
+	if err != nil {
+		return err
+	}
+
+	path := w.store.path(w.attachment.GetId())
+	err = os.Rename(path+partSuffix, path)
+	if err != nil {
+		return err
+	}
+
+	b, err := protojson.Marshal(w.attachment)
+	if err != nil {
+		return err
+	}
+	err = writeFileSync(path+metadataSuffix, b)
+	if err != nil {
+		os.Remove(path)
+		return err
+	}
+	//without the sync of the directory the renamed file could be lost after a crash, so the upload fails
+	//and nothing of it is kept, otherwise a reload could find it without the client knowing it was saved
+	err = syncDir(w.store.dir)
+	if err != nil {
+		os.Remove(path)
+		os.Remove(path + metadataSuffix)
+		return err
+	}
+
+	w.store.mu.Lock()
+	w.store.attachments[w.attachment.GetId()] = proto.Clone(w.attachment).(*blogpb.Attachment)
+	w.store.mu.Unlock()
+
+	return nil
+}
+
+// abort removes what was received of the attachment
+func (w *attachmentWriter) abort() {
+	w.file.Close()
+	os.Remove(w.store.path(w.attachment.GetId()) + partSuffix)
+}
+
+func (a *attachmentStore) get(attachmentID string) (*blogpb.Attachment, error) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	attachment, ok := a.attachments[attachmentID]
+	if !ok {
+		return nil, errAttachmentNotFound
+	}
+	return proto.Clone(attachment).(*blogpb.Attachment), nil
+}
+
+// open returns the attachment and its content, the caller must close the file
+func (a *attachmentStore) open(attachmentID string) (*blogpb.Attachment, *os.File, error) {
+
+	attachment, err := a.get(attachmentID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	file, err := os.Open(a.path(attachmentID))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil, errAttachmentNotFound //it was removed after the get
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return attachment, file, nil
+}
+
+// list returns the attachments of the blog, the oldest first
+func (a *attachmentStore) list(blogID string) []*blogpb.Attachment {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	attachments := make([]*blogpb.Attachment, 0)
+	for _, attachment := range a.attachments {
+		if attachment.GetBlogId() == blogID {
+			attachments = append(attachments, proto.Clone(attachment).(*blogpb.Attachment))
+		}
+	}
+	sort.Slice(attachments, func(i, j int) bool {
+		ti, tj := attachments[i].GetCreatedAt().AsTime(), attachments[j].GetCreatedAt().AsTime()
+		if !ti.Equal(tj) {
+			return ti.Before(tj)
+		}
+		return attachments[i].GetId() < attachments[j].GetId()
+	})
+
+	return attachments
+}
+
+// deleteBlog removes all the attachments of the blog
+func (a *attachmentStore) deleteBlog(blogID string) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	for id, attachment := range a.attachments {
+		if attachment.GetBlogId() != blogID {
+			continue
+		}
+
+		//the metadata goes first, so a failure never leaves an attachment without content
+		path := a.path(id)
+		err := os.Remove(path + metadataSuffix)
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+		delete(a.attachments, id)
+		os.Remove(path)
+	}
+
+	return nil
+}
+
+func (a *attachmentStore) tooLarge() error {
+	return fmt.Errorf("%w, the limit is %v bytes", errAttachmentTooLarge, a.maxSize)
+}
+
+func (a *attachmentStore) path(attachmentID string) string {
+	return filepath.Join(a.dir, attachmentID)
+}
+
+// contentTypeMatches checks the first bytes of the content, so a file can't be sent with a false content type
+func contentTypeMatches(contentType string, head []byte) bool {
+	detected := http.DetectContentType(head)
+	if strings.HasPrefix(contentType, "text/") {
+		return strings.HasPrefix(detected, "text/plain")
+	}
+	return detected == contentType
+}
+
+// writeFileSync writes the file in a temporary path and renames it, so the file is never left half written
+func writeFileSync(path string, b []byte) error {
+
+	tmp := path + partSuffix
+	file, err := os.Create(tmp)
+	if err != nil {
+		return err
+	}
+	_, err = file.Write(b)
+	if err == nil {
+		err = file.Sync()
+	}
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(tmp)
+		return err
+	}
+
+	return os.Rename(tmp, path)
+}
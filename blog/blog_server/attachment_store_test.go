@@ -0,0 +1,107 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/diegoclair/grpc-go-course/blog/blogpb"
+)
+
+func checksum(b []byte) string {
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
+
+// uploadTestAttachment writes the content in one chunk and commits it with the checksum
+func uploadTestAttachment(a *attachmentStore, id, contentType string, content []byte, sum string) (*blogpb.Attachment, error) {
+	w, err := a.create(&blogpb.Attachment{Id: id, BlogId: "blog", FileName: id, ContentType: contentType})
+	if err != nil {
+		return nil, err
+	}
+	err = w.write(content)
+	if err != nil {
+		w.abort()
+		return nil, err
+	}
+	return w.commit(sum)
+}
+
+func TestAttachmentStoreUploadAndReload(t *testing.T) {
+	dir := t.TempDir()
+	a, err := newAttachmentStore(dir, 1<<10)
+	if err != nil {
+		t.Fatalf("newAttachmentStore: %v", err)
+	}
+
+	content := []byte("hello attachments")
+	saved, err := uploadTestAttachment(a, "a", "text/plain", content, checksum(content))
+	if err != nil {
+		t.Fatalf("upload: %v", err)
+	}
+	if saved.GetSize() != int64(len(content)) || saved.GetSha256() != checksum(content) {
+		t.Errorf("the attachment should have the size and checksum of the content, got %v", saved)
+	}
+
+	//an upload that stopped between the content and the metadata is removed when the store is loaded
+	err = ioutil.WriteFile(filepath.Join(dir, "orphan"), content, 0644)
+	if err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	a, err = newAttachmentStore(dir, 1<<10)
+	if err != nil {
+		t.Fatalf("newAttachmentStore: %v", err)
+	}
+	attachment, file, err := a.open("a")
+	if err != nil {
+		t.Fatalf("open: %v", err)
+	}
+	defer file.Close()
+	b, err := ioutil.ReadAll(file)
+	if err != nil || string(b) != string(content) || attachment.GetSha256() != saved.GetSha256() {
+		t.Errorf("the reloaded attachment is %v with %q (%v)", attachment, b, err)
+	}
+	files, err := ioutil.ReadDir(dir)
+	if err != nil || len(files) != 2 {
+		t.Errorf("the directory should only have the content and the metadata of a, got %v (%v)", len(files), err)
+	}
+}
+
+func TestAttachmentStoreRejects(t *testing.T) {
+	dir := t.TempDir()
+	a, err := newAttachmentStore(dir, 16)
+	if err != nil {
+		t.Fatalf("newAttachmentStore: %v", err)
+	}
+
+	png := []byte("\x89PNG\r\n\x1a\n")
+	tests := []struct {
+		name        string
+		contentType string
+		content     []byte
+		checksum    string
+		want        error
+	}{
+		{"wrong checksum", "text/plain", []byte("text"), checksum([]byte("other")), errChecksumMismatch},
+		{"false content type", "image/png", []byte("text"), checksum([]byte("text")), errContentTypeMismatch},
+		{"forbidden content type", "text/html", []byte("<p>"), checksum([]byte("<p>")), errContentTypeForbidden},
+		{"too large", "text/plain", []byte("more than sixteen bytes"), checksum([]byte("more than sixteen bytes")), errAttachmentTooLarge},
+		{"png", "image/png", png, checksum(png), nil},
+	}
+	for i, test := range tests {
+		_, err := uploadTestAttachment(a, string(rune('a'+i)), test.contentType, test.content, test.checksum)
+		if !errors.Is(err, test.want) {
+			t.Errorf("%v: the upload returned %v, want %v", test.name, err, test.want)
+		}
+	}
+
+	//the failed uploads don't leave files behind
+	files, err := ioutil.ReadDir(dir)
+	if err != nil || len(files) != 2 {
+		t.Errorf("the directory should only have the png, got %v files (%v)", len(files), err)
+	}
+}
@@ -0,0 +1,238 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/diegoclair/grpc-go-course/blog/blogpb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	attachmentChunkSize   = 64 * 1024 //size of the chunks sent by DownloadAttachment
+	maxAttachmentFileName = 255
+)
+
+func (s *server) UploadAttachment(stream blogpb.BlogService_UploadAttachmentServer) error {
+	fmt.Println("UploadAttachment function was invoked with a streaming request")
+	t := tenantFromContext(stream.Context())
+
+	//the metadata comes first, so we can reject the upload before receiving the file
+	req, err := stream.Recv()
+	if err == io.EOF {
+		return status.Errorf(codes.InvalidArgument, "The metadata is required")
+	}
+	if err != nil {
+		return err
+	}
+	metadata := req.GetMetadata()
+	if metadata == nil {
+		return status.Errorf(codes.InvalidArgument, "The first message must have the metadata")
+	}
+
+	attachment, err := t.newAttachment(stream.Context(), metadata)
+	if err != nil {
+		return err
+	}
+	writer, err := t.attachments.create(attachment)
+	if err != nil {
+		return attachmentError(err, attachment.GetId())
+	}
+
+	checksum := ""
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			writer.abort()
+			return err
+		}
+
+		switch data := req.GetData().(type) {
+		case *blogpb.UploadAttachmentRequest_Chunk:
+			if checksum != "" {
+				writer.abort()
+				return status.Errorf(codes.InvalidArgument, "The sha256 must be the last message")
+			}
+			err = writer.write(data.Chunk)
+			if err != nil {
+				writer.abort()
+				return attachmentError(err, attachment.GetId())
+			}
+		case *blogpb.UploadAttachmentRequest_Sha256:
+			checksum = data.Sha256
+		default:
+			writer.abort()
+			return status.Errorf(codes.InvalidArgument, "Only the first message can have the metadata")
+		}
+	}
+
+	if checksum == "" {
+		writer.abort()
+		return status.Errorf(codes.InvalidArgument, "The sha256 is required in the last message")
+	}
+	if metadata.GetSize() > 0 && metadata.GetSize() != writer.attachment.GetSize() {
+		writer.abort()
+		return status.Errorf(codes.InvalidArgument, "The metadata size is %v bytes, but %v bytes were received", metadata.GetSize(), writer.attachment.GetSize())
+	}
+
+	saved, err := writer.commit(checksum)
+	if err != nil {
+		return attachmentError(err, attachment.GetId())
+	}
+
+	return stream.SendAndClose(&blogpb.UploadAttachmentResponse{
+		Attachment: saved,
+	})
+}
+
+// newAttachment validates the metadata and returns the attachment that will be saved
+func (t *tenant) newAttachment(ctx context.Context, metadata *blogpb.AttachmentMetadata) (*blogpb.Attachment, error) {
+
+	if metadata.GetBlogId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "The blog_id is required")
+	}
+	fileName := strings.TrimSpace(metadata.GetFileName())
+	if !isValidFileName(fileName) {
+		return nil, status.Errorf(codes.InvalidArgument, "The file_name must have up to %v bytes, without path separators or control characters", maxAttachmentFileName)
+	}
+	if metadata.GetSize() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "The size can't be negative")
+	}
+
+	_, err := t.store.ReadBlog(ctx, metadata.GetBlogId())
+	if errors.Is(err, errBlogNotFound) {
+		return nil, status.Errorf(codes.FailedPrecondition, "The blog with id %v doesn't exist", metadata.GetBlogId())
+	}
+	if err != nil {
+		return nil, storeError(err, metadata.GetBlogId())
+	}
+
+	id, err := newID()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not generate the attachment id: %v", err)
+	}
+
+	return &blogpb.Attachment{
+		Id:          id,
+		BlogId:      metadata.GetBlogId(),
+		FileName:    fileName,
+		ContentType: strings.ToLower(strings.TrimSpace(metadata.GetContentType())),
+		Size:        metadata.GetSize(),
+		CreatedAt:   timestamppb.Now(),
+	}, nil
+}
+
+func (s *server) DownloadAttachment(req *blogpb.DownloadAttachmentRequest, stream blogpb.BlogService_DownloadAttachmentServer) error {
+	fmt.Printf("DownloadAttachment function was invoked with %v\n", req)
+	t := tenantFromContext(stream.Context())
+
+	attachmentID := req.GetAttachmentId()
+	if attachmentID == "" {
+		return status.Errorf(codes.InvalidArgument, "The attachment_id is required")
+	}
+
+	attachment, file, err := t.attachments.open(attachmentID)
+	if err != nil {
+		return attachmentError(err, attachmentID)
+	}
+	defer file.Close()
+
+	offset := req.GetOffset()
+	if offset < 0 || offset > attachment.GetSize() {
+		return status.Errorf(codes.OutOfRange, "The offset must be between 0 and the attachment size %v", attachment.GetSize())
+	}
+
+	//the attachment goes in the first message even if the offset is the end of the file
+	buf := make([]byte, attachmentChunkSize)
+	for first := true; ; first = false {
+		n, err := file.ReadAt(buf, offset)
+		if err != nil && err != io.EOF {
+			return status.Errorf(codes.Internal, "Could not read the attachment: %v", err)
+		}
+
+		if n > 0 || first {
+			res := &blogpb.DownloadAttachmentResponse{
+				Chunk:  buf[:n],
+				Offset: offset,
+			}
+			if first {
+				res.Attachment = attachment
+			}
+			sendErr := stream.Send(res)
+			if sendErr != nil {
+				return sendErr
+			}
+		}
+		if err == io.EOF {
+			return nil
+		}
+		offset += int64(n)
+	}
+}
+
+func (s *server) ListAttachments(ctx context.Context, req *blogpb.ListAttachmentsRequest) (*blogpb.ListAttachmentsResponse, error) {
+	fmt.Printf("ListAttachments function was invoked with %v\n", req)
+	t := tenantFromContext(ctx)
+
+	if req.GetBlogId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "The blog_id is required")
+	}
+
+	return &blogpb.ListAttachmentsResponse{
+		Attachments: t.attachments.list(req.GetBlogId()),
+	}, nil
+}
+
+// attachmentError converts the errors returned by the attachmentStore to grpc status errors
+func attachmentError(err error, id string) error {
+	switch {
+	case errors.Is(err, errAttachmentNotFound):
+		return status.Errorf(codes.NotFound, "Cannot find attachment with id: %v", id)
+	case errors.Is(err, errAttachmentTooLarge):
+		return status.Errorf(codes.InvalidArgument, "The %v", err) //the error has the limit
+	case errors.Is(err, errContentTypeForbidden):
+		return status.Errorf(codes.InvalidArgument, "The content_type is not allowed, it should be one of %v", allowedContentTypeList())
+	case errors.Is(err, errContentTypeMismatch):
+		return status.Errorf(codes.InvalidArgument, "The content of the file doesn't match the content_type")
+	case errors.Is(err, errChecksumMismatch):
+		return status.Errorf(codes.DataLoss, "The sha256 doesn't match the received file, upload it again")
+	}
+	return status.Errorf(codes.Internal, "Internal error: %v", err)
+}
+
+func allowedContentTypeList() string {
+	types := make([]string, 0, len(allowedContentTypes))
+	for contentType := range allowedContentTypes {
+		types = append(types, contentType)
+	}
+	sort.Strings(types)
+	return strings.Join(types, ", ")
+}
+
+func isValidFileName(name string) bool {
+	if name == "" || name == "." || name == ".." || len(name) > maxAttachmentFileName {
+		return false
+	}
+	return strings.IndexFunc(name, func(r rune) bool {
+		return r == '/' || r == '\\' || unicode.IsControl(r)
+	}) < 0
+}
+
+// deleteAttachments removes the attachments of a blog that was removed, a failure doesn't fail the request
+func (t *tenant) deleteAttachments(blogID string) {
+	err := t.attachments.deleteBlog(blogID)
+	if err != nil {
+		log.Printf("Error while deleting the attachments of the blog %v: %v", blogID, err)
+	}
+}
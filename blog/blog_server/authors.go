@@ -0,0 +1,115 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/diegoclair/grpc-go-course/blog/blogpb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *server) CreateAuthor(ctx context.Context, req *blogpb.CreateAuthorRequest) (*blogpb.CreateAuthorResponse, error) {
+	fmt.Printf("CreateAuthor function was invoked with %v\n", req)
+	t := tenantFromContext(ctx)
+
+	created, err := t.createAuthor(ctx, req.GetAuthor(), false)
+	if err != nil {
+		return nil, err
+	}
+
+	return &blogpb.CreateAuthorResponse{
+		Author: created,
+	}, nil
+}
+
+// createAuthor validates and saves a new author.
+// When imported is true, the created_at of the author is kept if it is filled.
+func (t *tenant) createAuthor(ctx context.Context, author *blogpb.Author, imported bool) (*blogpb.Author, error) {
+
+	if author == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "The author is required")
+	}
+	if strings.TrimSpace(author.GetName()) == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "The author name is required")
+	}
+
+	data := proto.Clone(author).(*blogpb.Author)
+	if data.GetId() == "" {
+		id, err := newID()
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not generate the author id: %v", err)
+		}
+		data.Id = id
+	}
+	if !imported || data.GetCreatedAt() == nil {
+		data.CreatedAt = timestamppb.Now()
+	}
+
+	created, err := t.store.CreateAuthor(ctx, data)
+	if err != nil {
+		return nil, storeError(err, data.GetId())
+	}
+
+	return created, nil
+}
+
+func (s *server) GetAuthor(ctx context.Context, req *blogpb.GetAuthorRequest) (*blogpb.GetAuthorResponse, error) {
+	fmt.Printf("GetAuthor function was invoked with %v\n", req)
+	t := tenantFromContext(ctx)
+
+	authorID := req.GetAuthorId()
+	if authorID == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "The author_id is required")
+	}
+
+	author, err := t.store.ReadAuthor(ctx, authorID)
+	if err != nil {
+		return nil, storeError(err, authorID)
+	}
+
+	return &blogpb.GetAuthorResponse{
+		Author: author,
+	}, nil
+}
+
+func (s *server) ListAuthors(req *blogpb.ListAuthorsRequest, stream blogpb.BlogService_ListAuthorsServer) error {
+	fmt.Printf("ListAuthors function was invoked with %v\n", req)
+	t := tenantFromContext(stream.Context())
+
+	pageSize, afterID, err := parsePage(req.GetPageSize(), req.GetCursor())
+	if err != nil {
+		return err
+	}
+
+	err = t.store.ListAuthors(stream.Context(), afterID, pageSize, func(author *blogpb.Author) error {
+		return stream.Send(&blogpb.ListAuthorsResponse{
+			Author: author,
+			Cursor: encodeCursor(author.GetId()),
+		})
+	})
+	if err != nil {
+		return storeError(err, "")
+	}
+
+	return nil
+}
+
+// checkAuthor returns a FAILED_PRECONDITION error if the author doesn't exist,
+// it is used by the requests that reference an author, like the blog creation
+func (t *tenant) checkAuthor(ctx context.Context, authorID string) error {
+
+	_, err := t.store.ReadAuthor(ctx, authorID)
+	if errors.Is(err, errAuthorNotFound) {
+		return status.Errorf(codes.FailedPrecondition, "The author with id %v doesn't exist, create it first", authorID)
+	}
+	if err != nil {
+		return storeError(err, authorID)
+	}
+
+	return nil
+}
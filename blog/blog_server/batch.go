@@ -0,0 +1,186 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/diegoclair/grpc-go-course/blog/blogpb"
+	"google.golang.org/genproto/googleapis/rpc/code"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// The operations of a batch are validated in order, each one over the blogs as the previous ones left them,
+// and only then the store saves all of them with WriteBlogs. The store checks the revisions again,
+// so a blog changed by another request in the meantime aborts the whole batch.
+
+const (
+	maxBatchOperations = 100
+	batchErrorReason   = "BATCH_OPERATION_FAILED"
+)
+
+func (s *server) BatchWriteBlogs(ctx context.Context, req *blogpb.BatchWriteBlogsRequest) (*blogpb.BatchWriteBlogsResponse, error) {
+	fmt.Printf("BatchWriteBlogs function was invoked with %v\n", req)
+	t := tenantFromContext(ctx)
+
+	operations := req.GetOperations()
+	if len(operations) == 0 || len(operations) > maxBatchOperations {
+		return nil, status.Errorf(codes.InvalidArgument, "The batch must have between 1 and %v operations", maxBatchOperations)
+	}
+
+	writes := make([]blogWrite, 0, len(operations))
+	written := make(map[string]*blogpb.Blog) //blog id -> the blog as the previous operations left it
+	var blogs, contentBytes int64
+	for i, op := range operations {
+		w, previous, err := t.prepareOperation(ctx, op, written)
+		if err != nil {
+			return nil, batchAborted(i, err, operationBlogID(op))
+		}
+		if w.Create {
+			blogs++
+		}
+		contentBytes += int64(len(w.Blog.GetContent()) - len(previous.GetContent()))
+
+		writes = append(writes, w)
+		written[w.Blog.GetId()] = w.Blog
+	}
+
+	t.quotaMu.Lock()
+	defer t.quotaMu.Unlock()
+	err := t.checkQuota(ctx, blogs, contentBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	err = t.store.WriteBlogs(ctx, writes)
+	if err != nil {
+		var batchErr *batchError
+		if errors.As(err, &batchErr) {
+			return nil, batchAborted(batchErr.Index, batchErr.Err, writes[batchErr.Index].Blog.GetId())
+		}
+		return nil, storeError(err, "")
+	}
+
+	res := &blogpb.BatchWriteBlogsResponse{
+		Results: make([]*blogpb.BlogWriteResult, 0, len(writes)),
+	}
+	for _, w := range writes {
+		eventType := blogpb.BlogEventType_BLOG_EVENT_TYPE_UPDATED
+		if w.Create {
+			eventType = blogpb.BlogEventType_BLOG_EVENT_TYPE_CREATED
+		} else if w.Blog.GetDeletedAt() != nil {
+			eventType = blogpb.BlogEventType_BLOG_EVENT_TYPE_DELETED
+		}
+		t.blogChanged(eventType, w.Blog)
+
+		res.Results = append(res.Results, &blogpb.BlogWriteResult{
+			Blog: w.Blog,
+		})
+	}
+
+	return res, nil
+}
+
+// prepareOperation validates the operation and returns its write, with the blog that it replaces, nil for a create.
+// The written blogs are the ones of the previous operations, they are used instead of the stored ones.
+func (t *tenant) prepareOperation(ctx context.Context, op *blogpb.BlogWriteOperation, written map[string]*blogpb.Blog) (blogWrite, *blogpb.Blog, error) {
+
+	read := func(blogID string) (*blogpb.Blog, error) {
+		blog, ok := written[blogID]
+		if !ok {
+			return t.store.ReadBlog(ctx, blogID)
+		}
+		if blog.GetDeletedAt() != nil {
+			return nil, errBlogNotFound
+		}
+		return proto.Clone(blog).(*blogpb.Blog), nil
+	}
+
+	switch op.GetOperation().(type) {
+	case *blogpb.BlogWriteOperation_Create:
+		data, err := t.newBlog(ctx, op.GetCreate().GetBlog(), false)
+		if err != nil {
+			return blogWrite{}, nil, err
+		}
+		if _, ok := written[data.GetId()]; ok {
+			return blogWrite{}, nil, errBlogAlreadyExists
+		}
+		return blogWrite{Blog: data, Create: true}, nil, nil
+
+	case *blogpb.BlogWriteOperation_Update:
+		req := op.GetUpdate()
+		mask, err := parseUpdateRequest(req)
+		if err != nil {
+			return blogWrite{}, nil, err
+		}
+		current, err := read(req.GetBlog().GetId())
+		if err != nil {
+			return blogWrite{}, nil, err
+		}
+		data, err := t.updatedBlog(ctx, current, req.GetBlog(), mask)
+		if err != nil {
+			return blogWrite{}, nil, err
+		}
+		data, err = nextRevision(current, data, req.GetExpectedRevision())
+		if err != nil {
+			return blogWrite{}, nil, err
+		}
+		return blogWrite{Blog: data, ExpectedRevision: current.GetRevision()}, current, nil
+
+	case *blogpb.BlogWriteOperation_Delete:
+		req := op.GetDelete()
+		if req.GetBlogId() == "" {
+			return blogWrite{}, nil, status.Errorf(codes.InvalidArgument, "The blog_id is required")
+		}
+		if req.GetPermanent() {
+			return blogWrite{}, nil, status.Errorf(codes.InvalidArgument, "The deletes of a batch only move the blogs to the trash, permanent can't be true")
+		}
+		current, err := read(req.GetBlogId())
+		if err != nil {
+			return blogWrite{}, nil, err
+		}
+		//like in TrashBlog, the blog goes to the trash with the same revision
+		data := proto.Clone(current).(*blogpb.Blog)
+		data.DeletedAt = timestamppb.Now()
+		return blogWrite{Blog: data, ExpectedRevision: current.GetRevision()}, current, nil
+	}
+
+	return blogWrite{}, nil, status.Errorf(codes.InvalidArgument, "The operation must have a create, update or delete")
+}
+
+// operationBlogID returns the id of the blog of the operation, it is empty for a create without id
+func operationBlogID(op *blogpb.BlogWriteOperation) string {
+	switch op.GetOperation().(type) {
+	case *blogpb.BlogWriteOperation_Create:
+		return op.GetCreate().GetBlog().GetId()
+	case *blogpb.BlogWriteOperation_Update:
+		return op.GetUpdate().GetBlog().GetId()
+	}
+	return op.GetDelete().GetBlogId()
+}
+
+// batchAborted returns the ABORTED error of a batch that failed in the operation index,
+// the ErrorInfo detail has the index and the code of the error, so the clients don't need to parse the message
+func batchAborted(index int, err error, blogID string) error {
+
+	cause := status.Convert(storeError(err, blogID))
+	st := status.Newf(codes.Aborted, "The operation %v failed and no operation was applied: %v", index, cause.Message())
+	detailed, detailsErr := st.WithDetails(&errdetails.ErrorInfo{
+		Reason: batchErrorReason,
+		Domain: "blog.BlogService",
+		Metadata: map[string]string{
+			"index": strconv.Itoa(index),
+			"code":  code.Code_name[int32(cause.Code())],
+		},
+	})
+	if detailsErr != nil {
+		return st.Err()
+	}
+
+	return detailed.Err()
+}
@@ -0,0 +1,178 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/diegoclair/grpc-go-course/blog/blogpb"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func createOperation(authorID, title string) *blogpb.BlogWriteOperation {
+	return &blogpb.BlogWriteOperation{Operation: &blogpb.BlogWriteOperation_Create{
+		Create: &blogpb.CreateBlogRequest{Blog: &blogpb.Blog{AuthorId: authorID, Title: title}},
+	}}
+}
+
+func updateOperation(blog *blogpb.Blog, expectedRevision int64) *blogpb.BlogWriteOperation {
+	return &blogpb.BlogWriteOperation{Operation: &blogpb.BlogWriteOperation_Update{
+		Update: &blogpb.UpdateBlogRequest{Blog: blog, ExpectedRevision: expectedRevision},
+	}}
+}
+
+func deleteOperation(blogID string) *blogpb.BlogWriteOperation {
+	return &blogpb.BlogWriteOperation{Operation: &blogpb.BlogWriteOperation_Delete{
+		Delete: &blogpb.DeleteBlogRequest{BlogId: blogID},
+	}}
+}
+
+// countBlogs returns how many blogs the tenant of the context has, outside of the trash
+func countBlogs(t *testing.T, ctx context.Context) int {
+	t.Helper()
+	return len(blogIDs(t, tenantFromContext(ctx).store))
+}
+
+func TestBatchWriteBlogs(t *testing.T) {
+	s, ctx := newTestServer(t)
+	authorID := createTestAuthor(t, s, ctx)
+	updated := createTestBlog(t, s, ctx, authorID, "before")
+	deleted := createTestBlog(t, s, ctx, authorID, "deleted")
+
+	//the second update sees the blog as the first one left it
+	first := &blogpb.Blog{Id: updated.GetId(), AuthorId: authorID, Title: "first"}
+	second := &blogpb.Blog{Id: updated.GetId(), AuthorId: authorID, Title: "second"}
+	res, err := s.BatchWriteBlogs(ctx, &blogpb.BatchWriteBlogsRequest{Operations: []*blogpb.BlogWriteOperation{
+		createOperation(authorID, "created"),
+		updateOperation(first, updated.GetRevision()),
+		updateOperation(second, updated.GetRevision()+1),
+		deleteOperation(deleted.GetId()),
+	}})
+	if err != nil {
+		t.Fatalf("BatchWriteBlogs: %v", err)
+	}
+
+	results := res.GetResults()
+	if len(results) != 4 || results[0].GetBlog().GetId() == "" || results[2].GetBlog().GetRevision() != 3 || results[3].GetBlog().GetDeletedAt() == nil {
+		t.Fatalf("BatchWriteBlogs returned %v", results)
+	}
+	read, err := s.ReadBlog(ctx, &blogpb.ReadBlogRequest{BlogId: updated.GetId()})
+	if err != nil || read.GetBlog().GetTitle() != "second" {
+		t.Errorf("the blog should have the title of the last update, got %v (%v)", read, err)
+	}
+	if n := countBlogs(t, ctx); n != 2 {
+		t.Errorf("the tenant should have the created and the updated blogs, got %v blogs", n)
+	}
+}
+
+func TestBatchWriteBlogsAborts(t *testing.T) {
+	s, ctx := newTestServer(t)
+	authorID := createTestAuthor(t, s, ctx)
+	blog := createTestBlog(t, s, ctx, authorID, "before")
+
+	tests := []struct {
+		name       string
+		operations []*blogpb.BlogWriteOperation
+		index      string
+		code       string
+	}{
+		{"old revision", []*blogpb.BlogWriteOperation{
+			createOperation(authorID, "not created"),
+			updateOperation(&blogpb.Blog{Id: blog.GetId(), AuthorId: authorID, Title: "stale"}, blog.GetRevision()+1),
+		}, "1", "ABORTED"},
+		{"invalid create", []*blogpb.BlogWriteOperation{
+			createOperation(authorID, "not created"),
+			createOperation(authorID, ""),
+		}, "1", "INVALID_ARGUMENT"},
+		{"deleted twice", []*blogpb.BlogWriteOperation{
+			deleteOperation(blog.GetId()),
+			deleteOperation(blog.GetId()),
+		}, "1", "NOT_FOUND"},
+		{"unknown author", []*blogpb.BlogWriteOperation{
+			createOperation("unknown", "title"),
+		}, "0", "FAILED_PRECONDITION"},
+	}
+	for _, test := range tests {
+		_, err := s.BatchWriteBlogs(ctx, &blogpb.BatchWriteBlogsRequest{Operations: test.operations})
+		st := status.Convert(err)
+		if st.Code() != codes.Aborted {
+			t.Errorf("%v: BatchWriteBlogs returned %v, want ABORTED", test.name, err)
+			continue
+		}
+
+		var info *errdetails.ErrorInfo
+		for _, detail := range st.Details() {
+			if d, ok := detail.(*errdetails.ErrorInfo); ok {
+				info = d
+			}
+		}
+		if info.GetReason() != batchErrorReason || info.GetMetadata()["index"] != test.index || info.GetMetadata()["code"] != test.code {
+			t.Errorf("%v: the ErrorInfo is %v, want the index %v and the code %v", test.name, info, test.index, test.code)
+		}
+	}
+
+	//no operation of the failed batches was applied
+	if n := countBlogs(t, ctx); n != 1 {
+		t.Errorf("the tenant should only have the first blog, got %v blogs", n)
+	}
+	read, err := s.ReadBlog(ctx, &blogpb.ReadBlogRequest{BlogId: blog.GetId()})
+	if err != nil || read.GetBlog().GetRevision() != blog.GetRevision() {
+		t.Errorf("the blog should not be changed, got %v (%v)", read, err)
+	}
+
+	_, err = s.BatchWriteBlogs(ctx, &blogpb.BatchWriteBlogsRequest{})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("BatchWriteBlogs without operations returned %v, want INVALID_ARGUMENT", err)
+	}
+}
+
+func TestWriteBlogsRevisionConflict(t *testing.T) {
+	ctx := context.Background()
+	store := newMemoryStore()
+	_, err := store.CreateBlog(ctx, testBlog("a", "blog a"))
+	if err != nil {
+		t.Fatalf("CreateBlog: %v", err)
+	}
+
+	//the blog was changed after the batch read it, so the store rejects the whole batch
+	err = store.WriteBlogs(ctx, []blogWrite{
+		{Blog: testBlog("b", "blog b"), Create: true},
+		{Blog: &blogpb.Blog{Id: "a", AuthorId: "author", Title: "changed", Revision: 3}, ExpectedRevision: 2},
+	})
+	var batchErr *batchError
+	if !errors.As(err, &batchErr) || batchErr.Index != 1 || !errors.Is(batchErr.Err, errRevisionConflict) {
+		t.Fatalf("WriteBlogs returned %v, want a revision conflict in the write 1", err)
+	}
+	if ids := blogIDs(t, store); len(ids) != 1 {
+		t.Errorf("no write of the batch should be applied, got %v", ids)
+	}
+}
+
+// standaloneStore is a memory store that can't write batches, like a mongo without a replica set
+type standaloneStore struct {
+	*memoryStore
+}
+
+func (s standaloneStore) WriteBlogs(ctx context.Context, writes []blogWrite) error {
+	return errTransactionsUnsupported
+}
+
+func TestBatchWriteBlogsWithoutTransactions(t *testing.T) {
+	s, ctx := newTestServer(t)
+	authorID := createTestAuthor(t, s, ctx)
+	tn := tenantFromContext(ctx)
+	tn.store = standaloneStore{memoryStore: tn.store.(*memoryStore)}
+
+	_, err := s.BatchWriteBlogs(ctx, &blogpb.BatchWriteBlogsRequest{Operations: []*blogpb.BlogWriteOperation{createOperation(authorID, "created")}})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("BatchWriteBlogs without transactions returned %v, want FAILED_PRECONDITION", err)
+	}
+
+	//the mongo store checks it before it starts the session
+	err = (&mongoStore{}).WriteBlogs(ctx, nil)
+	if !errors.Is(err, errTransactionsUnsupported) {
+		t.Errorf("WriteBlogs of a standalone mongo returned %v, want errTransactionsUnsupported", err)
+	}
+}
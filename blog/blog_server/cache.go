@@ -0,0 +1,295 @@
+package main
+
+import (
+	"container/list"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/diegoclair/grpc-go-course/blog/blogpb"
+	"google.golang.org/protobuf/proto"
+)
+
+// The popular blogs are read much more than they are written, so each tenant keeps the last blogs read and the
+// last pages of ListBlogs in memory, in front of its storage. A write to a blog removes the blog and the pages
+// that have it, or could have it now, from the cache, so a read after a write always sees the write.
+// The writes of other servers that use the same mongo database are not seen, those servers should run with
+// -cache-size=0 or accept that a changed blog is returned until it is evicted.
+
+const cacheEntryOverhead = 200 //approximate bytes of an entry without its blogs, so the empty pages also count
+
+// cachedStore is a read-through cache of ReadBlog and of the ListBlogs pages (with a Limit) of the store.
+// The other reads go straight to the store. All the writes of BlogStore must be overridden to remove
+// their blogs from the cache, a new write method that is not overridden would leave stale entries.
+type cachedStore struct {
+	BlogStore
+
+	mu      sync.Mutex
+	maxSize int64
+	size    int64
+	lru     *list.List               //entries from the most to the least recently used
+	entries map[string]*list.Element //key -> element of the lru with a *cacheEntry
+	writes  int64                    //incremented by each write, a read that happened during a write is not kept
+
+	hits          int64
+	misses        int64
+	evictions     int64
+	invalidations int64
+}
+
+type cacheEntry struct {
+	key   string
+	size  int64
+	blogs []*blogpb.Blog
+
+	//only for the pages, a page that has filter.Limit blogs ends at its last blog, otherwise it has all the blogs after AfterID
+	page   bool
+	filter blogFilter
+}
+
+func newCachedStore(store BlogStore, maxSize int64) *cachedStore {
+	return &cachedStore{
+		BlogStore: store,
+		maxSize:   maxSize,
+		lru:       list.New(),
+		entries:   make(map[string]*list.Element),
+	}
+}
+
+func (c *cachedStore) ReadBlog(ctx context.Context, blogID string) (*blogpb.Blog, error) {
+
+	key := "blog:" + blogID
+	if blogs, ok := c.get(key); ok {
+		return blogs[0], nil
+	}
+
+	writes := c.writeCount()
+	blog, err := c.BlogStore.ReadBlog(ctx, blogID)
+	if err != nil {
+		return nil, err
+	}
+	c.put(&cacheEntry{key: key, blogs: []*blogpb.Blog{blog}}, writes)
+
+	return blog, nil
+}
+
+func (c *cachedStore) ListBlogs(ctx context.Context, filter blogFilter, fn func(blog *blogpb.Blog) error) error {
+
+	//the listings without a limit are the full scans of the server, like the stats, they would fill the cache
+	if filter.Limit <= 0 {
+		return c.BlogStore.ListBlogs(ctx, filter, fn)
+	}
+
+	b, err := json.Marshal(filter)
+	if err != nil {
+		return err
+	}
+	key := "page:" + string(b)
+
+	blogs, ok := c.get(key)
+	if !ok {
+		//the page is read whole before it is returned, so fn can be slow without holding the store
+		writes := c.writeCount()
+		err = c.BlogStore.ListBlogs(ctx, filter, func(blog *blogpb.Blog) error {
+			blogs = append(blogs, blog)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		c.put(&cacheEntry{key: key, blogs: blogs, page: true, filter: filter}, writes)
+	}
+
+	for _, blog := range blogs {
+		err := fn(blog)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *cachedStore) CreateBlog(ctx context.Context, blog *blogpb.Blog) (*blogpb.Blog, error) {
+	defer c.invalidate(blog.GetId())
+	return c.BlogStore.CreateBlog(ctx, blog)
+}
+
+func (c *cachedStore) UpdateBlog(ctx context.Context, blog *blogpb.Blog, expectedRevision int64) (*blogpb.Blog, error) {
+	defer c.invalidate(blog.GetId())
+	return c.BlogStore.UpdateBlog(ctx, blog, expectedRevision)
+}
+
+func (c *cachedStore) DeleteBlog(ctx context.Context, blogID string, cascade bool) (*blogpb.Blog, error) {
+	defer c.invalidate(blogID)
+	return c.BlogStore.DeleteBlog(ctx, blogID, cascade)
+}
+
+func (c *cachedStore) TrashBlog(ctx context.Context, blogID string, deletedAt time.Time) (*blogpb.Blog, error) {
+	defer c.invalidate(blogID)
+	return c.BlogStore.TrashBlog(ctx, blogID, deletedAt)
+}
+
+func (c *cachedStore) RestoreBlog(ctx context.Context, blogID string) (*blogpb.Blog, error) {
+	defer c.invalidate(blogID)
+	return c.BlogStore.RestoreBlog(ctx, blogID)
+}
+
+func (c *cachedStore) PurgeBlogs(ctx context.Context, deletedBefore time.Time) ([]*blogpb.Blog, error) {
+
+	//the purged blogs were in the trash, so they are only in the pages of the trash
+	purged, err := c.BlogStore.PurgeBlogs(ctx, deletedBefore)
+	blogIDs := make([]string, 0, len(purged))
+	for _, blog := range purged {
+		blogIDs = append(blogIDs, blog.GetId())
+	}
+	c.invalidate(blogIDs...)
+
+	return purged, err
+}
+
+func (c *cachedStore) WriteBlogs(ctx context.Context, writes []blogWrite) error {
+
+	blogIDs := make([]string, 0, len(writes))
+	for _, w := range writes {
+		blogIDs = append(blogIDs, w.Blog.GetId())
+	}
+	defer c.invalidate(blogIDs...)
+
+	return c.BlogStore.WriteBlogs(ctx, writes)
+}
+
+// get returns a copy of the blogs of the entry, so the caller can change them
+func (c *cachedStore) get(key string) ([]*blogpb.Blog, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	element, ok := c.entries[key]
+	if !ok {
+		c.misses++
+		return nil, false
+	}
+	c.hits++
+	c.lru.MoveToFront(element)
+
+	entry := element.Value.(*cacheEntry)
+	blogs := make([]*blogpb.Blog, 0, len(entry.blogs))
+	for _, blog := range entry.blogs {
+		blogs = append(blogs, proto.Clone(blog).(*blogpb.Blog))
+	}
+	return blogs, true
+}
+
+// put keeps a copy of the entry read by the store, unless there was a write after writes was taken
+func (c *cachedStore) put(entry *cacheEntry, writes int64) {
+
+	blogs := make([]*blogpb.Blog, 0, len(entry.blogs))
+	entry.size = cacheEntryOverhead
+	for _, blog := range entry.blogs {
+		blogs = append(blogs, proto.Clone(blog).(*blogpb.Blog))
+		entry.size += int64(proto.Size(blog))
+	}
+	entry.blogs = blogs
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.writes != writes || entry.size > c.maxSize {
+		return
+	}
+	if element, ok := c.entries[entry.key]; ok {
+		c.remove(element) //another read of the same key was faster
+	}
+
+	c.entries[entry.key] = c.lru.PushFront(entry)
+	c.size += entry.size
+	for c.size > c.maxSize {
+		c.remove(c.lru.Back())
+		c.evictions++
+	}
+}
+
+func (c *cachedStore) writeCount() int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.writes
+}
+
+// invalidate removes the blogs and the pages where they are or could be after the write.
+// It doesn't matter if the write failed, the entries are read again from the store.
+func (c *cachedStore) invalidate(blogIDs ...string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.writes++
+	for _, blogID := range blogIDs {
+		if element, ok := c.entries["blog:"+blogID]; ok {
+			c.remove(element)
+			c.invalidations++
+		}
+	}
+
+	//the filter of the page is not checked, the blog could match it before or after the write
+	for element := c.lru.Front(); element != nil; {
+		next := element.Next()
+		entry := element.Value.(*cacheEntry)
+		for _, blogID := range blogIDs {
+			if !entry.page {
+				break
+			}
+			if pageCovers(entry, blogID) {
+				c.remove(element)
+				c.invalidations++
+				break
+			}
+		}
+		element = next
+	}
+}
+
+// pageCovers checks if the blog is in the range of ids of the page
+func pageCovers(entry *cacheEntry, blogID string) bool {
+	if blogID <= entry.filter.AfterID {
+		return false
+	}
+	if len(entry.blogs) < entry.filter.Limit {
+		return true //it was the last page, a new blog can be added to its end
+	}
+	return blogID <= entry.blogs[len(entry.blogs)-1].GetId()
+}
+
+// remove takes the entry out of the cache, c.mu must be held
+func (c *cachedStore) remove(element *list.Element) {
+	entry := c.lru.Remove(element).(*cacheEntry)
+	delete(c.entries, entry.key)
+	c.size -= entry.size
+}
+
+func (c *cachedStore) stats() *blogpb.GetCacheStatsResponse {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return &blogpb.GetCacheStatsResponse{
+		Enabled:       true,
+		Hits:          c.hits,
+		Misses:        c.misses,
+		Evictions:     c.evictions,
+		Invalidations: c.invalidations,
+		Entries:       int64(c.lru.Len()),
+		SizeBytes:     c.size,
+		MaxSizeBytes:  c.maxSize,
+	}
+}
+
+func (s *server) GetCacheStats(ctx context.Context, req *blogpb.GetCacheStatsRequest) (*blogpb.GetCacheStatsResponse, error) {
+	fmt.Printf("GetCacheStats function was invoked with %v\n", req)
+	t := tenantFromContext(ctx)
+
+	if t.cache == nil {
+		return &blogpb.GetCacheStatsResponse{}, nil
+	}
+	return t.cache.stats(), nil
+}
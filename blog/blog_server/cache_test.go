@@ -0,0 +1,154 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/diegoclair/grpc-go-course/blog/blogpb"
+)
+
+func TestPageCovers(t *testing.T) {
+	full := &cacheEntry{
+		page:   true,
+		filter: blogFilter{AfterID: "b", Limit: 2},
+		blogs:  []*blogpb.Blog{{Id: "c"}, {Id: "d"}},
+	}
+	last := &cacheEntry{
+		page:   true,
+		filter: blogFilter{AfterID: "b", Limit: 2},
+		blogs:  []*blogpb.Blog{{Id: "c"}},
+	}
+
+	tests := []struct {
+		name   string
+		entry  *cacheEntry
+		blogID string
+		want   bool
+	}{
+		{"before the page", full, "a", false},
+		{"the cursor of the page", full, "b", false},
+		{"first blog", full, "c", true},
+		{"between the blogs", full, "cc", true},
+		{"last blog", full, "d", true},
+		{"after a full page", full, "e", false},
+		{"before the last page", last, "a", false},
+		{"after the last page", last, "z", true},
+	}
+	for _, test := range tests {
+		if got := pageCovers(test.entry, test.blogID); got != test.want {
+			t.Errorf("%v: pageCovers(%v) = %v, want %v", test.name, test.blogID, got, test.want)
+		}
+	}
+}
+
+// listPage returns the ids of the page of the cache
+func listPage(t *testing.T, c *cachedStore, afterID string, limit int) []string {
+	t.Helper()
+
+	ids := make([]string, 0)
+	err := c.ListBlogs(context.Background(), blogFilter{AfterID: afterID, Limit: limit}, func(blog *blogpb.Blog) error {
+		ids = append(ids, blog.GetId())
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("ListBlogs: %v", err)
+	}
+	return ids
+}
+
+func TestCachedStoreInvalidation(t *testing.T) {
+	ctx := context.Background()
+	c := newCachedStore(newMemoryStore(), 1<<20)
+	for _, id := range []string{"a", "b", "c", "d"} {
+		_, err := c.CreateBlog(ctx, testBlog(id, "blog "+id))
+		if err != nil {
+			t.Fatalf("CreateBlog: %v", err)
+		}
+	}
+
+	//the blog read is kept, and a change of the returned copy doesn't change the cache
+	blog, err := c.ReadBlog(ctx, "a")
+	if err != nil {
+		t.Fatalf("ReadBlog: %v", err)
+	}
+	blog.Title = "changed by the caller"
+	blog, err = c.ReadBlog(ctx, "a")
+	if err != nil || blog.GetTitle() != "blog a" {
+		t.Fatalf("ReadBlog returned %v (%v), want the cached blog a", blog, err)
+	}
+	if stats := c.stats(); stats.GetHits() != 1 || stats.GetMisses() != 1 {
+		t.Fatalf("the second read should be a hit, got %v", stats)
+	}
+
+	//an update is seen by the next read
+	_, err = c.UpdateBlog(ctx, testBlog("a", "updated"), 1)
+	if err != nil {
+		t.Fatalf("UpdateBlog: %v", err)
+	}
+	blog, err = c.ReadBlog(ctx, "a")
+	if err != nil || blog.GetTitle() != "updated" {
+		t.Fatalf("ReadBlog after the update returned %v (%v)", blog, err)
+	}
+
+	if ids := listPage(t, c, "", 2); len(ids) != 2 || ids[1] != "b" {
+		t.Fatalf("the first page should be a and b, got %v", ids)
+	}
+	if ids := listPage(t, c, "b", 2); len(ids) != 2 || ids[1] != "d" {
+		t.Fatalf("the second page should be c and d, got %v", ids)
+	}
+
+	//the new blog is only in the range of the second page, so the first one is still cached
+	_, err = c.CreateBlog(ctx, testBlog("bb", "blog bb"))
+	if err != nil {
+		t.Fatalf("CreateBlog: %v", err)
+	}
+	before := c.stats()
+	if ids := listPage(t, c, "", 2); len(ids) != 2 || ids[1] != "b" {
+		t.Fatalf("the first page should still be a and b, got %v", ids)
+	}
+	if ids := listPage(t, c, "b", 2); len(ids) != 2 || ids[0] != "bb" || ids[1] != "c" {
+		t.Fatalf("the second page should have the new blog, got %v", ids)
+	}
+	after := c.stats()
+	if after.GetHits()-before.GetHits() != 1 || after.GetMisses()-before.GetMisses() != 1 {
+		t.Errorf("the first page should be a hit and the second a miss, got %v then %v", before, after)
+	}
+
+	//a blog moved to the trash is not read from the cache anymore
+	_, err = c.TrashBlog(ctx, "bb", time.Now())
+	if err != nil {
+		t.Fatalf("TrashBlog: %v", err)
+	}
+	if ids := listPage(t, c, "b", 2); len(ids) != 2 || ids[0] != "c" {
+		t.Errorf("the second page should not have the blog in the trash, got %v", ids)
+	}
+	if _, err = c.ReadBlog(ctx, "bb"); !errors.Is(err, errBlogNotFound) {
+		t.Errorf("ReadBlog of the blog in the trash returned %v, want errBlogNotFound", err)
+	}
+}
+
+func TestCachedStoreSkipsReadsDuringWrites(t *testing.T) {
+	c := newCachedStore(newMemoryStore(), 1<<20)
+
+	//the blog was read before a write that finished first, so it can be older than the store
+	writes := c.writeCount()
+	c.invalidate("a")
+	c.put(&cacheEntry{key: "blog:a", blogs: []*blogpb.Blog{testBlog("a", "old")}}, writes)
+	if stats := c.stats(); stats.GetEntries() != 0 {
+		t.Errorf("the read during a write should not be cached, got %v", stats)
+	}
+
+	//the oldest entries are evicted when the cache is full
+	c = newCachedStore(newMemoryStore(), 3*cacheEntryOverhead)
+	for _, id := range []string{"a", "b", "c", "d"} {
+		c.put(&cacheEntry{key: "blog:" + id, blogs: []*blogpb.Blog{testBlog(id, "")}}, c.writeCount())
+	}
+	if _, ok := c.get("blog:a"); ok {
+		t.Errorf("the least recently used entry should be evicted")
+	}
+	if stats := c.stats(); stats.GetEvictions() == 0 || stats.GetSizeBytes() > stats.GetMaxSizeBytes() {
+		t.Errorf("the cache should evict to stay in its size, got %v", stats)
+	}
+}
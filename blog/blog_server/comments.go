@@ -0,0 +1,109 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/diegoclair/grpc-go-course/blog/blogpb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *server) CreateComment(ctx context.Context, req *blogpb.CreateCommentRequest) (*blogpb.CreateCommentResponse, error) {
+	fmt.Printf("CreateComment function was invoked with %v\n", req)
+	t := tenantFromContext(ctx)
+
+	comment := req.GetComment()
+	if comment == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "The comment is required")
+	}
+	if comment.GetBlogId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "The comment blog_id is required")
+	}
+	if comment.GetAuthorId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "The comment author_id is required")
+	}
+	if strings.TrimSpace(comment.GetContent()) == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "The comment content is required")
+	}
+	err := t.checkAuthor(ctx, comment.GetAuthorId())
+	if err != nil {
+		return nil, err
+	}
+
+	//the comment id is always generated by the server
+	data := proto.Clone(comment).(*blogpb.Comment)
+	data.Id, err = newID()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not generate the comment id: %v", err)
+	}
+	data.CreatedAt = timestamppb.Now()
+
+	created, err := t.store.CreateComment(ctx, data)
+	if errors.Is(err, errBlogNotFound) {
+		return nil, status.Errorf(codes.FailedPrecondition, "The blog with id %v doesn't exist", comment.GetBlogId())
+	}
+	if err != nil {
+		return nil, storeError(err, data.GetId())
+	}
+
+	return &blogpb.CreateCommentResponse{
+		Comment: created,
+	}, nil
+}
+
+func (s *server) ListComments(req *blogpb.ListCommentsRequest, stream blogpb.BlogService_ListCommentsServer) error {
+	fmt.Printf("ListComments function was invoked with %v\n", req)
+	t := tenantFromContext(stream.Context())
+
+	blogID := req.GetBlogId()
+	if blogID == "" {
+		return status.Errorf(codes.InvalidArgument, "The blog_id is required")
+	}
+
+	pageSize, afterID, err := parsePage(req.GetPageSize(), req.GetCursor())
+	if err != nil {
+		return err
+	}
+
+	//we check the blog, so an unknown blog is an error and not an empty list
+	_, err = t.store.ReadBlog(stream.Context(), blogID)
+	if err != nil {
+		return storeError(err, blogID)
+	}
+
+	err = t.store.ListComments(stream.Context(), blogID, afterID, pageSize, func(comment *blogpb.Comment) error {
+		return stream.Send(&blogpb.ListCommentsResponse{
+			Comment: comment,
+			Cursor:  encodeCursor(comment.GetId()),
+		})
+	})
+	if err != nil {
+		return storeError(err, blogID)
+	}
+
+	return nil
+}
+
+func (s *server) DeleteComment(ctx context.Context, req *blogpb.DeleteCommentRequest) (*blogpb.DeleteCommentResponse, error) {
+	fmt.Printf("DeleteComment function was invoked with %v\n", req)
+	t := tenantFromContext(ctx)
+
+	commentID := req.GetCommentId()
+	if commentID == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "The comment_id is required")
+	}
+
+	err := t.store.DeleteComment(ctx, commentID)
+	if err != nil {
+		return nil, storeError(err, commentID)
+	}
+
+	return &blogpb.DeleteCommentResponse{
+		CommentId: commentID,
+	}, nil
+}
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/diegoclair/grpc-go-course/blog/blogpb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// listCommentsStream keeps the comments sent by ListComments
+type listCommentsStream struct {
+	grpc.ServerStream
+	ctx       context.Context
+	responses []*blogpb.ListCommentsResponse
+}
+
+func (s *listCommentsStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *listCommentsStream) Send(res *blogpb.ListCommentsResponse) error {
+	s.responses = append(s.responses, res)
+	return nil
+}
+
+func TestCreateCommentErrors(t *testing.T) {
+	s, ctx := newTestServer(t)
+	authorID := createTestAuthor(t, s, ctx)
+	blog := createTestBlog(t, s, ctx, authorID, "commented")
+
+	tests := []struct {
+		name    string
+		comment *blogpb.Comment
+		code    codes.Code
+	}{
+		{"without comment", nil, codes.InvalidArgument},
+		{"without blog", &blogpb.Comment{AuthorId: authorID, Content: "nice"}, codes.InvalidArgument},
+		{"without author", &blogpb.Comment{BlogId: blog.GetId(), Content: "nice"}, codes.InvalidArgument},
+		{"without content", &blogpb.Comment{BlogId: blog.GetId(), AuthorId: authorID, Content: " "}, codes.InvalidArgument},
+		{"unknown author", &blogpb.Comment{BlogId: blog.GetId(), AuthorId: "unknown", Content: "nice"}, codes.FailedPrecondition},
+		{"unknown blog", &blogpb.Comment{BlogId: "unknown", AuthorId: authorID, Content: "nice"}, codes.FailedPrecondition},
+	}
+	for _, test := range tests {
+		_, err := s.CreateComment(ctx, &blogpb.CreateCommentRequest{Comment: test.comment})
+		if status.Code(err) != test.code {
+			t.Errorf("%v: CreateComment returned %v, want %v", test.name, err, test.code)
+		}
+	}
+}
+
+func TestDeleteBlogWithComments(t *testing.T) {
+	s, ctx := newTestServer(t)
+	authorID := createTestAuthor(t, s, ctx)
+	blog := createTestBlog(t, s, ctx, authorID, "commented")
+
+	for _, content := range []string{"first", "second"} {
+		_, err := s.CreateComment(ctx, &blogpb.CreateCommentRequest{Comment: &blogpb.Comment{BlogId: blog.GetId(), AuthorId: authorID, Content: content}})
+		if err != nil {
+			t.Fatalf("CreateComment: %v", err)
+		}
+	}
+	stream := &listCommentsStream{ctx: ctx}
+	err := s.ListComments(&blogpb.ListCommentsRequest{BlogId: blog.GetId()}, stream)
+	if err != nil || len(stream.responses) != 2 {
+		t.Fatalf("ListComments should return the 2 comments, got %v (%v)", stream.responses, err)
+	}
+
+	//the comments would be orphans, so they must be removed with the blog
+	_, err = s.DeleteBlog(ctx, &blogpb.DeleteBlogRequest{BlogId: blog.GetId(), Permanent: true})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("DeleteBlog of a blog with comments returned %v, want FAILED_PRECONDITION", err)
+	}
+	_, err = s.DeleteBlog(ctx, &blogpb.DeleteBlogRequest{BlogId: blog.GetId(), Permanent: true, Cascade: true})
+	if err != nil {
+		t.Fatalf("DeleteBlog with cascade: %v", err)
+	}
+	err = s.ListComments(&blogpb.ListCommentsRequest{BlogId: blog.GetId()}, &listCommentsStream{ctx: ctx})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("ListComments of the deleted blog returned %v, want NOT_FOUND", err)
+	}
+}
@@ -0,0 +1,283 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// The settings of the server are its flags. Each flag can also be set by an environment variable with the
+// BLOG_ prefix, like BLOG_MONGO_URI for -mongo-uri, or by the -config file, in json or yaml, with the flag names as keys:
+//
+//	listen-address: ":50051"
+//	storage: mongo
+//	mongo-database: blog
+//
+// A flag of the command line wins over the environment variable, that wins over the file.
+// Only a flat list of keys is supported in yaml, it is read without a yaml library.
+
+const envPrefix = "BLOG_"
+
+// where the value of a flag came from
+const (
+	sourceDefault = "default"
+	sourceFile    = "file"
+	sourceEnv     = "env"
+	sourceFlag    = "flag"
+)
+
+// secretFlags are the flags whose values are not printed
+var secretFlags = map[string]bool{
+	"mongo-password": true,
+}
+
+// loadConfig fills the flags that were not in the command line with the environment variables and the config file,
+// it returns the source of each flag
+func loadConfig() (map[string]string, error) {
+
+	sources := make(map[string]string)
+	flag.VisitAll(func(f *flag.Flag) {
+		sources[f.Name] = sourceDefault
+	})
+	flag.Visit(func(f *flag.Flag) {
+		sources[f.Name] = sourceFlag
+	})
+
+	//the config file can also come from the environment, so it is the first one
+	if value, ok := os.LookupEnv(envName("config")); ok && sources["config"] == sourceDefault {
+		*configFile = value
+		sources["config"] = sourceEnv
+	}
+
+	if *configFile != "" {
+		values, err := readConfigFile(*configFile)
+		if err != nil {
+			return nil, err
+		}
+		for key, value := range values {
+			name := strings.Replace(key, "_", "-", -1)
+			if flag.Lookup(name) == nil || name == "config" {
+				return nil, fmt.Errorf("the config file has the unknown setting %q", key)
+			}
+			if sources[name] != sourceDefault {
+				continue
+			}
+			err = flag.Set(name, value)
+			if err != nil {
+				return nil, fmt.Errorf("the %v of the config file is invalid: %w", key, err)
+			}
+			sources[name] = sourceFile
+		}
+	}
+
+	var err error
+	flag.VisitAll(func(f *flag.Flag) {
+		value, ok := os.LookupEnv(envName(f.Name))
+		if !ok || err != nil || sources[f.Name] == sourceFlag || f.Name == "config" {
+			return
+		}
+		if setErr := flag.Set(f.Name, value); setErr != nil {
+			err = fmt.Errorf("the %v environment variable is invalid: %w", envName(f.Name), setErr)
+			return
+		}
+		sources[f.Name] = sourceEnv
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return sources, nil
+}
+
+// envName returns the environment variable of the flag, like mongo-uri -> BLOG_MONGO_URI
+func envName(flagName string) string {
+	return envPrefix + strings.ToUpper(strings.Replace(flagName, "-", "_", -1))
+}
+
+// readConfigFile returns the settings of the file, the format is chosen by its extension
+func readConfigFile(path string) (map[string]string, error) {
+
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		return parseJSONConfig(b)
+	case ".yaml", ".yml":
+		return parseYAMLConfig(b)
+	}
+	return nil, fmt.Errorf("the config file %v must be .json, .yaml or .yml", path)
+}
+
+func parseJSONConfig(b []byte) (map[string]string, error) {
+
+	decoder := json.NewDecoder(bytes.NewReader(b))
+	decoder.UseNumber()
+	var file map[string]interface{}
+	err := decoder.Decode(&file)
+	if err != nil {
+		return nil, fmt.Errorf("invalid json config file: %w", err)
+	}
+
+	values := make(map[string]string, len(file))
+	for key, value := range file {
+		switch v := value.(type) {
+		case string:
+			values[key] = v
+		case json.Number:
+			values[key] = v.String()
+		case bool:
+			values[key] = strconv.FormatBool(v)
+		default:
+			return nil, fmt.Errorf("the %v of the config file must be a string, number or boolean", key)
+		}
+	}
+
+	return values, nil
+}
+
+// parseYAMLConfig reads the lines like "key: value", the values can be quoted and the lines can have comments
+func parseYAMLConfig(b []byte) (map[string]string, error) {
+
+	values := make(map[string]string)
+	for i, line := range strings.Split(string(b), "\n") {
+		line = strings.TrimRight(line, " \t\r")
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || trimmed == "---" || strings.HasPrefix(trimmed, "#") {
+			continue
+		}
+		if trimmed != line {
+			return nil, fmt.Errorf("line %v of the config file is indented, only a flat list of keys is supported", i+1)
+		}
+
+		colon := strings.Index(line, ":")
+		if colon <= 0 {
+			return nil, fmt.Errorf("line %v of the config file should be like key: value", i+1)
+		}
+		key := strings.TrimSpace(line[:colon])
+		value, err := parseYAMLValue(strings.TrimSpace(line[colon+1:]))
+		if err != nil {
+			return nil, fmt.Errorf("line %v of the config file: %w", i+1, err)
+		}
+		if _, ok := values[key]; ok {
+			return nil, fmt.Errorf("line %v of the config file repeats the %v", i+1, key)
+		}
+		values[key] = value
+	}
+
+	return values, nil
+}
+
+func parseYAMLValue(value string) (string, error) {
+
+	switch {
+	case strings.HasPrefix(value, `"`):
+		end := strings.LastIndex(value, `"`)
+		if end == 0 || !isYAMLComment(value[end+1:]) {
+			return "", errors.New("the double quoted value is not closed")
+		}
+		return strconv.Unquote(value[:end+1])
+
+	case strings.HasPrefix(value, "'"):
+		end := strings.LastIndex(value, "'")
+		if end == 0 || !isYAMLComment(value[end+1:]) {
+			return "", errors.New("the single quoted value is not closed")
+		}
+		return strings.Replace(value[1:end], "''", "'", -1), nil
+
+	case strings.HasPrefix(value, "[") || strings.HasPrefix(value, "{") || strings.HasPrefix(value, "|") || strings.HasPrefix(value, ">"):
+		return "", errors.New("only single line values are supported")
+	}
+
+	//a comment starts with a # after a space, like in: storage: mongo # the production storage
+	if comment := strings.Index(value, " #"); comment >= 0 {
+		value = strings.TrimSpace(value[:comment])
+	}
+	if value == "~" || value == "null" {
+		return "", nil
+	}
+	return value, nil
+}
+
+func isYAMLComment(text string) bool {
+	text = strings.TrimSpace(text)
+	return text == "" || strings.HasPrefix(text, "#")
+}
+
+// validateConfig checks the settings together, the values of each flag were already parsed by flag.Set
+func validateConfig(sources map[string]string) error {
+
+	problems := make([]string, 0)
+	check := func(ok bool, format string, args ...interface{}) {
+		if !ok {
+			problems = append(problems, fmt.Sprintf(format, args...))
+		}
+	}
+
+	_, _, err := net.SplitHostPort(*listenAddress)
+	check(err == nil, "the listen-address must be like host:port or :port")
+
+	check(*storage == "memory" || *storage == "file" || *storage == "mongo", "the storage must be memory, file or mongo")
+	switch *storage {
+	case "file":
+		check(*filePath != "", "the file-path is required with the file storage")
+	case "mongo":
+		uri, err := url.Parse(*mongoURI)
+		check(err == nil && (uri.Scheme == "mongodb" || uri.Scheme == "mongodb+srv"), "the mongo-uri must be like mongodb://host:27017")
+		check(err != nil || uri.User == nil || sources["mongo-username"] == sourceDefault, "the mongo-uri already has credentials, don't set the mongo-username")
+		check(*mongoPassword == "" || *mongoUsername != "", "the mongo-password needs a mongo-username")
+		check(*mongoDatabase != "" && !strings.ContainsAny(*mongoDatabase, `/\. "$`), "the mongo-database can't be empty or have /\\. \"$")
+	}
+
+	check((*tlsCertFile == "") == (*tlsKeyFile == ""), "the tls-cert-file and the tls-key-file must be set together")
+	for _, path := range []string{*tlsCertFile, *tlsKeyFile, *tenantsFile} {
+		if path != "" {
+			_, err := os.Stat(path)
+			check(err == nil, "the file %v can't be read: %v", path, err)
+		}
+	}
+
+	check(*connectTimeout > 0, "the connect-timeout must be positive")
+	check(*shutdownTimeout > 0, "the shutdown-timeout must be positive")
+	check(*compactInterval >= 0, "the compact-interval can't be negative, 0 doesn't compact")
+	check(*trashRetention > 0, "the trash-retention must be positive")
+	check(*purgeInterval > 0, "the purge-interval must be positive")
+	check(*idempotencyRetention > 0, "the idempotency-retention must be positive")
+	check(*maxAttachmentSize > 0, "the max-attachment-size must be positive")
+	check(*maxBlogs >= 0 && *maxContentBytes >= 0, "the max-blogs and max-content-bytes can't be negative, 0 is unlimited")
+	check(*cacheSize >= 0, "the cache-size can't be negative, 0 disables the cache")
+
+	if len(problems) > 0 {
+		return errors.New(strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+// printConfig prints the value and the source of all the settings, without the secrets
+func printConfig(sources map[string]string) {
+
+	fmt.Println("Effective config:")
+	flag.VisitAll(func(f *flag.Flag) {
+		value := f.Value.String()
+		switch {
+		case secretFlags[f.Name] && value != "":
+			value = "*****"
+		case f.Name == "mongo-uri":
+			if uri, err := url.Parse(value); err == nil {
+				value = uri.Redacted()
+			}
+		}
+		fmt.Printf("  %v = %q (%v)\n", f.Name, value, sources[f.Name])
+	})
+}
@@ -0,0 +1,156 @@
+package main
+
+import (
+	"flag"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// withTestFlags runs the test with a command line without flags set, the values are restored at the end
+func withTestFlags(t *testing.T) {
+	t.Helper()
+
+	original := flag.CommandLine
+	values := make(map[string]string)
+	commandLine := flag.NewFlagSet(original.Name(), flag.ContinueOnError)
+	original.VisitAll(func(f *flag.Flag) {
+		values[f.Name] = f.Value.String()
+		commandLine.Var(f.Value, f.Name, f.Usage)
+	})
+	flag.CommandLine = commandLine
+
+	t.Cleanup(func() {
+		flag.CommandLine = original
+		original.VisitAll(func(f *flag.Flag) {
+			f.Value.Set(values[f.Name])
+		})
+	})
+}
+
+func setTestEnv(t *testing.T, name, value string) {
+	t.Helper()
+
+	os.Setenv(name, value)
+	t.Cleanup(func() { os.Unsetenv(name) })
+}
+
+func writeConfigFile(t *testing.T, name, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), name)
+	err := ioutil.WriteFile(path, []byte(content), 0644)
+	if err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+	return path
+}
+
+func TestLoadConfigPrecedence(t *testing.T) {
+	withTestFlags(t)
+
+	path := writeConfigFile(t, "blog.yaml", "listen-address: \":1\"\nfile-path: /file/blogs.log\nstorage: file\n")
+	setTestEnv(t, envName("config"), path)
+	setTestEnv(t, envName("listen-address"), ":2")
+	setTestEnv(t, envName("file-path"), "/env/blogs.log")
+	err := flag.CommandLine.Parse([]string{"-listen-address", ":3"})
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+
+	sources, err := loadConfig()
+	if err != nil {
+		t.Fatalf("loadConfig: %v", err)
+	}
+	tests := []struct {
+		name   string
+		value  string
+		source string
+	}{
+		{"listen-address", ":3", sourceFlag},
+		{"file-path", "/env/blogs.log", sourceEnv},
+		{"storage", "file", sourceFile},
+		{"mongo-database", "blog", sourceDefault},
+		{"config", path, sourceEnv},
+	}
+	for _, test := range tests {
+		value := flag.Lookup(test.name).Value.String()
+		if value != test.value || sources[test.name] != test.source {
+			t.Errorf("%v: got %q from the %v, want %q from the %v", test.name, value, sources[test.name], test.value, test.source)
+		}
+	}
+}
+
+func TestLoadConfigErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		file    string
+		content string
+		env     string
+	}{
+		{"unknown key", "blog.yaml", "storage: file\ncolor: blue\n", ""},
+		{"invalid value", "blog.json", `{"max-blogs": "many"}`, ""},
+		{"nested json", "blog.json", `{"storage": {"kind": "file"}}`, ""},
+		{"unknown extension", "blog.toml", `storage = "file"`, ""},
+		{"invalid env var", "blog.yaml", "storage: file\n", "ten"},
+	}
+
+	for _, test := range tests {
+		withTestFlags(t)
+		path := writeConfigFile(t, test.file, test.content)
+		err := flag.CommandLine.Parse([]string{"-config", path})
+		if err != nil {
+			t.Fatalf("Parse: %v", err)
+		}
+		if test.env != "" {
+			setTestEnv(t, envName("max-blogs"), test.env)
+		}
+
+		_, err = loadConfig()
+		if err == nil {
+			t.Errorf("%v: loadConfig should fail", test.name)
+		}
+		os.Unsetenv(envName("max-blogs"))
+	}
+}
+
+func TestParseYAMLConfig(t *testing.T) {
+	values, err := parseYAMLConfig([]byte("---\n# the settings\nstorage: file # the local storage\nfile-path: \"/tmp/a #b.log\"\nmongo-username: 'it''s me'\ntls-cert-file: ~\n\nmax-blogs: 10\r\n"))
+	if err != nil {
+		t.Fatalf("parseYAMLConfig: %v", err)
+	}
+	want := map[string]string{
+		"storage":        "file",
+		"file-path":      "/tmp/a #b.log",
+		"mongo-username": "it's me",
+		"tls-cert-file":  "",
+		"max-blogs":      "10",
+	}
+	if len(values) != len(want) {
+		t.Errorf("parseYAMLConfig returned %v, want %v", values, want)
+	}
+	for key, value := range want {
+		if values[key] != value {
+			t.Errorf("%v: got %q, want %q", key, values[key], value)
+		}
+	}
+
+	tests := []struct {
+		name    string
+		content string
+	}{
+		{"indented", "tenants:\n  team-a: key\n"},
+		{"without colon", "storage file\n"},
+		{"repeated key", "storage: file\nstorage: mongo\n"},
+		{"list", "storage: [file, mongo]\n"},
+		{"multiline", "storage: |\n"},
+		{"unclosed quote", "storage: \"file\n"},
+	}
+	for _, test := range tests {
+		_, err := parseYAMLConfig([]byte(test.content))
+		if err == nil {
+			t.Errorf("%v: parseYAMLConfig(%q) should fail", test.name, test.content)
+		}
+	}
+}
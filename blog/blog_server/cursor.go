@@ -0,0 +1,48 @@
+package main
+
+import (
+	"encoding/base64"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// the cursor is opaque to the clients, today it is only the id of the last sent blog,
+// but encoding it allows us to change its content without breaking them
+
+func encodeCursor(blogID string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(blogID))
+}
+
+func decodeCursor(cursor string) (blogID string, err error) {
+	if cursor == "" {
+		return "", nil
+	}
+
+	b, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil || len(b) == 0 {
+		return "", errors.New("the cursor is malformed")
+	}
+
+	return string(b), nil
+}
+
+// parsePage validates the pagination fields of the list requests, returning grpc status errors
+func parsePage(pageSize int32, cursor string) (limit int, afterID string, err error) {
+
+	if pageSize < 0 || pageSize > maxPageSize {
+		return 0, "", status.Errorf(codes.InvalidArgument, "The page_size must be between 0 and %v", maxPageSize)
+	}
+	limit = int(pageSize)
+	if limit == 0 {
+		limit = defaultPageSize
+	}
+
+	afterID, err = decodeCursor(cursor)
+	if err != nil {
+		return 0, "", status.Errorf(codes.InvalidArgument, "Invalid cursor: %v", err)
+	}
+
+	return limit, afterID, nil
+}
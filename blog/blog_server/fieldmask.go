@@ -0,0 +1,104 @@
+package main
+
+import (
+	"strings"
+
+	"github.com/diegoclair/grpc-go-course/blog/blogpb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// The field masks have the names of the Blog fields, like "title" or "tags". The read masks choose the
+// fields returned by the read and list rpcs, and the update mask chooses the fields changed by UpdateBlog.
+// Only the top level fields are supported, a path like "created_at.seconds" is rejected.
+
+// updatableBlogFields are the fields that the update mask can have, the others are filled by the server
+var updatableBlogFields = map[protoreflect.Name]bool{
+	"author_id": true,
+	"title":     true,
+	"content":   true,
+	"tags":      true,
+	"category":  true,
+}
+
+// blogMask is a validated field mask, a nil blogMask has all the fields
+type blogMask []protoreflect.FieldDescriptor
+
+// parseBlogMask validates the paths of the mask, name is the request field used in the error messages
+func parseBlogMask(mask *fieldmaskpb.FieldMask, name string) (blogMask, error) {
+
+	if len(mask.GetPaths()) == 0 {
+		return nil, nil
+	}
+
+	fields := (&blogpb.Blog{}).ProtoReflect().Descriptor().Fields()
+	parsed := make(blogMask, 0, len(mask.GetPaths()))
+	for _, path := range mask.GetPaths() {
+		field := fields.ByName(protoreflect.Name(path))
+		if field == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "The %v has the unknown path %q, it should be one of: %v", name, path, blogFieldNames())
+		}
+		parsed = append(parsed, field)
+	}
+
+	return parsed, nil
+}
+
+// parseUpdateMask validates the paths of the update_mask, only the fields sent by the clients can be in it
+func parseUpdateMask(mask *fieldmaskpb.FieldMask) (blogMask, error) {
+
+	parsed, err := parseBlogMask(mask, "update_mask")
+	if err != nil {
+		return nil, err
+	}
+	for _, field := range parsed {
+		if !updatableBlogFields[field.Name()] {
+			return nil, status.Errorf(codes.InvalidArgument, "The update_mask path %q is filled by the server and can't be updated", field.Name())
+		}
+	}
+
+	return parsed, nil
+}
+
+// read returns a blog with only the fields of the mask, the id is always kept so the client knows which blog it is
+func (m blogMask) read(blog *blogpb.Blog) *blogpb.Blog {
+
+	if m == nil || blog == nil {
+		return blog
+	}
+
+	src := blog.ProtoReflect()
+	masked := &blogpb.Blog{Id: blog.GetId()}
+	dst := masked.ProtoReflect()
+	for _, field := range m {
+		if src.Has(field) {
+			dst.Set(field, src.Get(field))
+		}
+	}
+
+	return masked
+}
+
+// merge copies the fields of the mask from src to dst, a field that is empty in src is cleared in dst
+func (m blogMask) merge(dst, src *blogpb.Blog) {
+
+	from, to := src.ProtoReflect(), dst.ProtoReflect()
+	for _, field := range m {
+		if from.Has(field) {
+			to.Set(field, from.Get(field))
+		} else {
+			to.Clear(field)
+		}
+	}
+}
+
+func blogFieldNames() string {
+	fields := (&blogpb.Blog{}).ProtoReflect().Descriptor().Fields()
+	names := make([]string, 0, fields.Len())
+	for i := 0; i < fields.Len(); i++ {
+		names = append(names, string(fields.Get(i).Name()))
+	}
+	return strings.Join(names, ", ")
+}
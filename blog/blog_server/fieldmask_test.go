@@ -0,0 +1,95 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/diegoclair/grpc-go-course/blog/blogpb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func TestParseBlogMask(t *testing.T) {
+	tests := []struct {
+		name   string
+		paths  []string
+		update bool
+		want   codes.Code
+	}{
+		{"empty mask", nil, false, codes.OK},
+		{"read fields", []string{"title", "created_at", "revision"}, false, codes.OK},
+		{"unknown field", []string{"title", "subtitle"}, false, codes.InvalidArgument},
+		{"nested path", []string{"created_at.seconds"}, false, codes.InvalidArgument},
+		{"camel case", []string{"authorId"}, false, codes.InvalidArgument},
+		{"updatable fields", []string{"title", "tags", "category"}, true, codes.OK},
+		{"field filled by the server", []string{"title", "revision"}, true, codes.InvalidArgument},
+		{"unknown field in the update", []string{"subtitle"}, true, codes.InvalidArgument},
+	}
+
+	for _, test := range tests {
+		mask := &fieldmaskpb.FieldMask{Paths: test.paths}
+		var err error
+		if test.update {
+			_, err = parseUpdateMask(mask)
+		} else {
+			_, err = parseBlogMask(mask, "read_mask")
+		}
+		if status.Code(err) != test.want {
+			t.Errorf("%v: the parse of %v returned %v, want %v", test.name, test.paths, err, test.want)
+		}
+	}
+}
+
+func TestBlogMaskReadAndMerge(t *testing.T) {
+	blog := &blogpb.Blog{Id: "a", AuthorId: "author", Title: "title", Content: "content", Tags: []string{"go"}}
+
+	mask, err := parseBlogMask(&fieldmaskpb.FieldMask{Paths: []string{"title", "tags"}}, "read_mask")
+	if err != nil {
+		t.Fatalf("parseBlogMask: %v", err)
+	}
+	read := mask.read(blog)
+	if read.GetId() != "a" || read.GetTitle() != "title" || len(read.GetTags()) != 1 || read.GetContent() != "" || read.GetAuthorId() != "" {
+		t.Errorf("the read should have only the id and the fields of the mask, got %v", read)
+	}
+
+	//the fields of the mask that are empty in the update are cleared, the others are kept
+	dst := &blogpb.Blog{Id: "a", AuthorId: "author", Title: "old", Content: "content", Tags: []string{"go"}}
+	mask.merge(dst, &blogpb.Blog{Title: "new", Content: "ignored"})
+	if dst.GetTitle() != "new" || len(dst.GetTags()) != 0 || dst.GetContent() != "content" {
+		t.Errorf("the merge should change only the title and the tags, got %v", dst)
+	}
+}
+
+func TestUpdateBlogWithMask(t *testing.T) {
+	s, ctx := newTestServer(t)
+	authorID := createTestAuthor(t, s, ctx)
+	created := createTestBlog(t, s, ctx, authorID, "before")
+
+	//the client only sends the title, the content is kept
+	res, err := s.UpdateBlog(ctx, &blogpb.UpdateBlogRequest{
+		Blog:             &blogpb.Blog{Id: created.GetId(), Title: "after"},
+		ExpectedRevision: created.GetRevision(),
+		UpdateMask:       &fieldmaskpb.FieldMask{Paths: []string{"title"}},
+	})
+	if err != nil {
+		t.Fatalf("UpdateBlog: %v", err)
+	}
+	if res.GetBlog().GetTitle() != "after" || res.GetBlog().GetContent() != created.GetContent() || res.GetBlog().GetAuthorId() != authorID {
+		t.Errorf("the update should change only the title, got %v", res.GetBlog())
+	}
+
+	//the title is required, so it can't be cleared by the mask
+	_, err = s.UpdateBlog(ctx, &blogpb.UpdateBlogRequest{
+		Blog:             &blogpb.Blog{Id: created.GetId()},
+		ExpectedRevision: res.GetBlog().GetRevision(),
+		UpdateMask:       &fieldmaskpb.FieldMask{Paths: []string{"title"}},
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("UpdateBlog clearing the title returned %v, want INVALID_ARGUMENT", err)
+	}
+
+	read, err := s.ReadBlog(ctx, &blogpb.ReadBlogRequest{BlogId: created.GetId(), ReadMask: &fieldmaskpb.FieldMask{Paths: []string{"revision"}}})
+	if err != nil || read.GetBlog().GetRevision() != 2 || read.GetBlog().GetTitle() != "" {
+		t.Errorf("ReadBlog with the mask should return only the id and the revision, got %v (%v)", read, err)
+	}
+}
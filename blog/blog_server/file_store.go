@@ -0,0 +1,726 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/diegoclair/grpc-go-course/blog/blogpb"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// The file store keeps all the blogs in memory and saves every change in an append-only log file.
+// Each record of the log has the format:
+//	length (4 bytes) | crc32 of the payload (4 bytes) | payload (length bytes)
+// and the payload is the operation (1 byte) followed by the protobuf encoded blog, author, comment or view count.
+// A batch is a single record whose payload has the records of all its blogs, so after a crash
+// the log has the whole batch or nothing of it.
+// A put of an existing blog moves the previous one to the history, so the log has all the revisions.
+// When the server starts, the log is replayed to rebuild the blogs. If the server crashed in the
+// middle of a write, the last record is incomplete, so we truncate the file at the last valid record.
+// Any other bad record, like one with a wrong checksum, stops the server with its offset: the records
+// after it are still valid, so truncating would lose them, the file must be fixed by hand.
+// From time to time the log is compacted: we write only the current blogs in a new file and rename it
+// over the old one, this way we never have a half written log.
+
+const (
+	recordHeaderSize = 8
+	maxRecordSize    = 64 << 20
+)
+
+type recordOp byte
+
+const (
+	opPutBlog       recordOp = 1
+	opDeleteBlog    recordOp = 2 //also deletes the comments of the blog
+	opPutAuthor     recordOp = 3
+	opPutComment    recordOp = 4
+	opDeleteComment recordOp = 5
+	opPutViews      recordOp = 6 //the total views of the blog, not the increment, so a record can be replayed twice
+	opBatch         recordOp = 7 //the payload has opPutBlog records, that are replayed together
+)
+
+var (
+	errCorruptedRecord = errors.New("corrupted record")
+	errTornRecord      = errors.New("torn record") //the file ended in the middle of the record
+)
+
+type fileStore struct {
+	mem *memoryStore
+
+	mu      sync.Mutex //serializes the writes, so the log has the same order as the memory changes
+	path    string
+	file    *os.File
+	size    int64 //size of the valid records, used to undo a partial write
+	records int   //number of records in the log, used to know when it is worth to compact
+
+	done chan struct{}
+	wg   sync.WaitGroup
+}
+
+func newFileStore(path string, compactInterval time.Duration) (*fileStore, error) {
+
+	err := os.MkdirAll(filepath.Dir(path), 0755)
+	if err != nil {
+		return nil, err
+	}
+
+	f := &fileStore{
+		mem:  newMemoryStore(),
+		path: path,
+		done: make(chan struct{}),
+	}
+
+	err = f.replay()
+	if err != nil {
+		return nil, err
+	}
+
+	f.file, err = os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0644)
+	if err != nil {
+		return nil, err
+	}
+
+	if compactInterval > 0 {
+		f.wg.Add(1)
+		go f.compactLoop(compactInterval)
+	}
+
+	return f, nil
+}
+
+// replay reads the log to rebuild the blogs in memory, dropping an incomplete last record
+func (f *fileStore) replay() error {
+
+	file, err := os.OpenFile(f.path, os.O_RDWR|os.O_CREATE, 0644)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	reader := bufio.NewReader(file)
+	var validSize int64
+	for {
+		op, payload, size, err := readRecord(reader)
+		if err == io.EOF {
+			break
+		}
+		if errors.Is(err, errTornRecord) {
+			log.Printf("The blog log %v has an incomplete record at offset %v (%v), truncating it", f.path, validSize, err)
+			err = file.Truncate(validSize)
+			if err != nil {
+				return err
+			}
+			err = file.Sync()
+			if err != nil {
+				return err
+			}
+			break
+		}
+		if err == nil {
+			err = f.apply(op, payload)
+		}
+		if err != nil {
+			return fmt.Errorf("the blog log %v has a bad record at offset %v: %w", f.path, validSize, err)
+		}
+
+		validSize += size
+		f.records++
+	}
+
+	f.size = validSize
+	fmt.Printf("Recovered %v records from %v\n", f.records, f.path)
+	return nil
+}
+
+// apply changes the memory state, it is used when replaying the log
+func (f *fileStore) apply(op recordOp, payload []byte) error {
+	ctx := context.Background()
+
+	switch op {
+	case opPutBlog, opDeleteBlog:
+		blog := &blogpb.Blog{}
+		err := proto.Unmarshal(payload, blog)
+		if err != nil {
+			return errCorruptedRecord
+		}
+		if op == opPutBlog {
+			f.mem.restoreBlog(blog)
+		} else {
+			f.mem.DeleteBlog(ctx, blog.GetId(), true)
+		}
+
+	case opPutAuthor:
+		author := &blogpb.Author{}
+		err := proto.Unmarshal(payload, author)
+		if err != nil {
+			return errCorruptedRecord
+		}
+		f.mem.CreateAuthor(ctx, author)
+
+	case opPutComment, opDeleteComment:
+		comment := &blogpb.Comment{}
+		err := proto.Unmarshal(payload, comment)
+		if err != nil {
+			return errCorruptedRecord
+		}
+		if op == opPutComment {
+			f.mem.restoreComment(comment)
+		} else {
+			f.mem.DeleteComment(ctx, comment.GetId())
+		}
+
+	case opPutViews:
+		count := &blogpb.BlogViewCount{}
+		err := proto.Unmarshal(payload, count)
+		if err != nil {
+			return errCorruptedRecord
+		}
+		f.mem.restoreViews(count.GetBlogId(), count.GetViews())
+
+	case opBatch:
+		//the inner records are checked before any of them is applied, so a bad batch changes nothing
+		blogs := make([]*blogpb.Blog, 0)
+		reader := bytes.NewReader(payload)
+		for {
+			innerOp, innerPayload, _, err := readRecord(reader)
+			if err == io.EOF {
+				break
+			}
+			if err != nil || innerOp != opPutBlog {
+				return errCorruptedRecord
+			}
+			blog := &blogpb.Blog{}
+			err = proto.Unmarshal(innerPayload, blog)
+			if err != nil {
+				return errCorruptedRecord
+			}
+			blogs = append(blogs, blog)
+		}
+		for _, blog := range blogs {
+			f.mem.restoreBlog(blog)
+		}
+
+	default:
+		return errCorruptedRecord
+	}
+
+	return nil
+}
+
+func readRecord(r io.Reader) (op recordOp, payload []byte, size int64, err error) {
+
+	header := make([]byte, recordHeaderSize)
+	n, err := io.ReadFull(r, header)
+	if err == io.EOF {
+		return op, nil, 0, io.EOF
+	}
+	if err == io.ErrUnexpectedEOF {
+		return op, nil, 0, fmt.Errorf("%w, the header has %v bytes", errTornRecord, n)
+	}
+	if err != nil {
+		return op, nil, 0, err
+	}
+
+	length := binary.BigEndian.Uint32(header[0:4])
+	checksum := binary.BigEndian.Uint32(header[4:8])
+	if length == 0 || length > maxRecordSize {
+		return op, nil, 0, errCorruptedRecord
+	}
+
+	payload = make([]byte, length)
+	_, err = io.ReadFull(r, payload)
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		return op, nil, 0, fmt.Errorf("%w, the payload has less than %v bytes", errTornRecord, length)
+	}
+	if err != nil {
+		return op, nil, 0, err
+	}
+	if crc32.ChecksumIEEE(payload) != checksum {
+		return op, nil, 0, errCorruptedRecord
+	}
+
+	return recordOp(payload[0]), payload[1:], int64(recordHeaderSize + length), nil
+}
+
+func encodeRecord(op recordOp, msg proto.Message) ([]byte, error) {
+
+	data, err := proto.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return frameRecord(op, data), nil
+}
+
+// frameRecord adds the operation and the header to the data
+func frameRecord(op recordOp, data []byte) []byte {
+
+	payload := append([]byte{byte(op)}, data...)
+	record := make([]byte, recordHeaderSize, recordHeaderSize+len(payload))
+	binary.BigEndian.PutUint32(record[0:4], uint32(len(payload)))
+	binary.BigEndian.PutUint32(record[4:8], crc32.ChecksumIEEE(payload))
+
+	return append(record, payload...)
+}
+
+// append writes the record and only returns after it is flushed to the disk, f.mu must be held
+func (f *fileStore) append(op recordOp, msg proto.Message) error {
+
+	record, err := encodeRecord(op, msg)
+	if err != nil {
+		return err
+	}
+
+	return f.write(record)
+}
+
+// write writes the encoded record and only returns after it is flushed to the disk, f.mu must be held
+func (f *fileStore) write(record []byte) error {
+
+	_, err := f.file.Write(record)
+	if err == nil {
+		err = f.file.Sync()
+	}
+	if err != nil {
+		//we remove what was written, otherwise the next records would be after a broken one
+		f.file.Truncate(f.size)
+		return err
+	}
+	f.size += int64(len(record))
+	f.records++
+
+	return nil
+}
+
+func (f *fileStore) CreateBlog(ctx context.Context, blog *blogpb.Blog) (*blogpb.Blog, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.mem.hasBlog(blog.GetId()) {
+		return nil, errBlogAlreadyExists
+	}
+
+	err := f.append(opPutBlog, blog)
+	if err != nil {
+		return nil, err
+	}
+
+	return f.mem.CreateBlog(ctx, blog)
+}
+
+func (f *fileStore) ReadBlog(ctx context.Context, blogID string) (*blogpb.Blog, error) {
+	return f.mem.ReadBlog(ctx, blogID)
+}
+
+func (f *fileStore) UpdateBlog(ctx context.Context, blog *blogpb.Blog, expectedRevision int64) (*blogpb.Blog, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	current, err := f.mem.ReadBlog(ctx, blog.GetId())
+	if err != nil {
+		return nil, err
+	}
+	data, err := nextRevision(current, blog, expectedRevision)
+	if err != nil {
+		return nil, err
+	}
+
+	err = f.append(opPutBlog, data)
+	if err != nil {
+		return nil, err
+	}
+	f.mem.restoreBlog(data)
+
+	return data, nil
+}
+
+func (f *fileStore) WriteBlogs(ctx context.Context, writes []blogWrite) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	//the memory only changes with f.mu held, so the writes can't fail after this check
+	err := f.mem.checkBlogWrites(writes)
+	if err != nil {
+		return err
+	}
+
+	batch := make([]byte, 0)
+	for _, w := range writes {
+		record, err := encodeRecord(opPutBlog, w.Blog)
+		if err != nil {
+			return err
+		}
+		batch = append(batch, record...)
+	}
+	if len(batch)+1 > maxRecordSize {
+		return fmt.Errorf("the batch has %v bytes, more than the %v of a record", len(batch), maxRecordSize)
+	}
+
+	err = f.write(frameRecord(opBatch, batch))
+	if err != nil {
+		return err
+	}
+
+	return f.mem.WriteBlogs(ctx, writes)
+}
+
+func (f *fileStore) DeleteBlog(ctx context.Context, blogID string, cascade bool) (*blogpb.Blog, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if !f.mem.hasBlog(blogID) {
+		return nil, errBlogNotFound
+	}
+	if !cascade && f.mem.hasComments(blogID) {
+		return nil, errBlogHasComments
+	}
+
+	err := f.append(opDeleteBlog, &blogpb.Blog{Id: blogID})
+	if err != nil {
+		return nil, err
+	}
+
+	return f.mem.DeleteBlog(ctx, blogID, true)
+}
+
+func (f *fileStore) TrashBlog(ctx context.Context, blogID string, deletedAt time.Time) (*blogpb.Blog, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	//the blog in the trash is saved as the same revision, so the replay doesn't add it to the history
+	data, err := f.mem.ReadBlog(ctx, blogID)
+	if err != nil {
+		return nil, err
+	}
+	data.DeletedAt = timestamppb.New(deletedAt)
+
+	err = f.append(opPutBlog, data)
+	if err != nil {
+		return nil, err
+	}
+	f.mem.restoreBlog(data)
+
+	return data, nil
+}
+
+func (f *fileStore) RestoreBlog(ctx context.Context, blogID string) (*blogpb.Blog, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	revisions := f.mem.blogRevisions(blogID)
+	if len(revisions) == 0 || revisions[len(revisions)-1].GetDeletedAt() == nil {
+		return nil, errBlogNotFound
+	}
+	data := revisions[len(revisions)-1]
+	data.DeletedAt = nil
+
+	err := f.append(opPutBlog, data)
+	if err != nil {
+		return nil, err
+	}
+	f.mem.restoreBlog(data)
+
+	return data, nil
+}
+
+func (f *fileStore) PurgeBlogs(ctx context.Context, deletedBefore time.Time) ([]*blogpb.Blog, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	expired := make([]*blogpb.Blog, 0)
+	err := f.mem.ListBlogs(ctx, blogFilter{Deleted: true}, func(blog *blogpb.Blog) error {
+		if blog.GetDeletedAt().AsTime().Before(deletedBefore) {
+			expired = append(expired, blog)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	//each blog is one record, if a write fails we return the blogs that were already purged
+	purged := make([]*blogpb.Blog, 0, len(expired))
+	for _, blog := range expired {
+		err = f.append(opDeleteBlog, &blogpb.Blog{Id: blog.GetId()})
+		if err != nil {
+			return purged, err
+		}
+		f.mem.DeleteBlog(ctx, blog.GetId(), true)
+		purged = append(purged, blog)
+	}
+
+	return purged, nil
+}
+
+func (f *fileStore) ListBlogs(ctx context.Context, filter blogFilter, fn func(blog *blogpb.Blog) error) error {
+	return f.mem.ListBlogs(ctx, filter, fn)
+}
+
+func (f *fileStore) CountTags(ctx context.Context, filter blogFilter) (map[string]int64, error) {
+	return f.mem.CountTags(ctx, filter)
+}
+
+func (f *fileStore) Usage(ctx context.Context) (blogUsage, error) {
+	return f.mem.Usage(ctx)
+}
+
+func (f *fileStore) RecordView(ctx context.Context, blogID string) (int64, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if _, err := f.mem.ReadBlog(ctx, blogID); err != nil {
+		return 0, err
+	}
+
+	views := f.mem.blogViews(blogID) + 1
+	err := f.append(opPutViews, &blogpb.BlogViewCount{BlogId: blogID, Views: views})
+	if err != nil {
+		return 0, err
+	}
+	f.mem.restoreViews(blogID, views)
+
+	return views, nil
+}
+
+func (f *fileStore) ViewCounts(ctx context.Context) (map[string]int64, error) {
+	return f.mem.ViewCounts(ctx)
+}
+
+func (f *fileStore) ReadBlogHistory(ctx context.Context, blogID string) ([]*blogpb.Blog, error) {
+	return f.mem.ReadBlogHistory(ctx, blogID)
+}
+
+func (f *fileStore) CreateAuthor(ctx context.Context, author *blogpb.Author) (*blogpb.Author, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if _, err := f.mem.ReadAuthor(ctx, author.GetId()); err == nil {
+		return nil, errAuthorAlreadyExists
+	}
+
+	err := f.append(opPutAuthor, author)
+	if err != nil {
+		return nil, err
+	}
+
+	return f.mem.CreateAuthor(ctx, author)
+}
+
+func (f *fileStore) ReadAuthor(ctx context.Context, authorID string) (*blogpb.Author, error) {
+	return f.mem.ReadAuthor(ctx, authorID)
+}
+
+func (f *fileStore) ListAuthors(ctx context.Context, afterID string, limit int, fn func(author *blogpb.Author) error) error {
+	return f.mem.ListAuthors(ctx, afterID, limit, fn)
+}
+
+func (f *fileStore) CreateComment(ctx context.Context, comment *blogpb.Comment) (*blogpb.Comment, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if _, err := f.mem.ReadBlog(ctx, comment.GetBlogId()); err != nil {
+		return nil, err
+	}
+
+	err := f.append(opPutComment, comment)
+	if err != nil {
+		return nil, err
+	}
+
+	return f.mem.CreateComment(ctx, comment)
+}
+
+func (f *fileStore) ListComments(ctx context.Context, blogID, afterID string, limit int, fn func(comment *blogpb.Comment) error) error {
+	return f.mem.ListComments(ctx, blogID, afterID, limit, fn)
+}
+
+func (f *fileStore) DeleteComment(ctx context.Context, commentID string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if !f.mem.hasComment(commentID) {
+		return errCommentNotFound
+	}
+
+	err := f.append(opDeleteComment, &blogpb.Comment{Id: commentID})
+	if err != nil {
+		return err
+	}
+
+	return f.mem.DeleteComment(ctx, commentID)
+}
+
+func (f *fileStore) compactLoop(interval time.Duration) {
+	defer f.wg.Done()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-f.done:
+			return
+		case <-ticker.C:
+			err := f.compact()
+			if err != nil {
+				log.Printf("Error while compacting the blog log: %v", err)
+			}
+		}
+	}
+}
+
+// compact rewrites the log with only the current blogs and their history
+func (f *fileStore) compact() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	records, err := f.snapshot()
+	if err != nil {
+		return err
+	}
+
+	//it is not worth to rewrite the file if most of the records are still alive
+	if f.records <= 2*len(records) {
+		return nil
+	}
+
+	//the new log is opened in append mode, so after the rename we can keep writing with the same handle
+	tmpPath := f.path + ".compact"
+	tmp, err := os.OpenFile(tmpPath, os.O_WRONLY|os.O_APPEND|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return err
+	}
+
+	size, err := writeSnapshot(tmp, records)
+	if err != nil {
+		tmp.Close()
+		os.Remove(tmpPath)
+		return err
+	}
+
+	//rename is atomic, so after a crash we have the old or the new log, never a mix of them
+	err = os.Rename(tmpPath, f.path)
+	if err != nil {
+		tmp.Close()
+		os.Remove(tmpPath)
+		return err
+	}
+	err = syncDir(filepath.Dir(f.path))
+	if err != nil {
+		log.Printf("Error while syncing the blog log directory: %v", err)
+	}
+
+	f.file.Close()
+	f.file = tmp
+
+	fmt.Printf("Blog log compacted from %v to %v records\n", f.records, len(records))
+	f.records = len(records)
+	f.size = size
+
+	return nil
+}
+
+type snapshotRecord struct {
+	op  recordOp
+	msg proto.Message
+}
+
+// snapshot returns the records needed to rebuild the current state, f.mu must be held.
+// The authors come first and the comments last, the same order that they could be created.
+func (f *fileStore) snapshot() ([]snapshotRecord, error) {
+	ctx := context.Background()
+	records := make([]snapshotRecord, 0)
+
+	err := f.mem.ListAuthors(ctx, "", 0, func(author *blogpb.Author) error {
+		records = append(records, snapshotRecord{op: opPutAuthor, msg: author})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	//the revisions are written from the oldest to the newest, so the replay rebuilds the same history
+	blogIDs := make([]string, 0)
+	for _, deleted := range []bool{false, true} {
+		err = f.mem.ListBlogs(ctx, blogFilter{Deleted: deleted}, func(blog *blogpb.Blog) error {
+			for _, revision := range f.mem.blogRevisions(blog.GetId()) {
+				records = append(records, snapshotRecord{op: opPutBlog, msg: revision})
+			}
+			blogIDs = append(blogIDs, blog.GetId())
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, blogID := range blogIDs {
+		if views := f.mem.blogViews(blogID); views > 0 {
+			records = append(records, snapshotRecord{op: opPutViews, msg: &blogpb.BlogViewCount{BlogId: blogID, Views: views}})
+		}
+		err = f.mem.ListComments(ctx, blogID, "", 0, func(comment *blogpb.Comment) error {
+			records = append(records, snapshotRecord{op: opPutComment, msg: comment})
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return records, nil
+}
+
+// writeSnapshot writes the records and flushes the file to the disk
+func writeSnapshot(file *os.File, records []snapshotRecord) (size int64, err error) {
+
+	writer := bufio.NewWriter(file)
+	for _, r := range records {
+		record, err := encodeRecord(r.op, r.msg)
+		if err != nil {
+			return 0, err
+		}
+		n, err := writer.Write(record)
+		if err != nil {
+			return 0, err
+		}
+		size += int64(n)
+	}
+
+	err = writer.Flush()
+	if err != nil {
+		return 0, err
+	}
+
+	return size, file.Sync()
+}
+
+// syncDir flushes the directory entry, so a rename survives a crash
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+	return d.Sync()
+}
+
+func (f *fileStore) Close(ctx context.Context) error {
+	close(f.done)
+	f.wg.Wait()
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.file.Close()
+}
@@ -0,0 +1,314 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/diegoclair/grpc-go-course/blog/blogpb"
+	"google.golang.org/protobuf/proto"
+)
+
+func openTestFileStore(t *testing.T, path string) *fileStore {
+	t.Helper()
+
+	f, err := newFileStore(path, 0)
+	if err != nil {
+		t.Fatalf("newFileStore: %v", err)
+	}
+	return f
+}
+
+func testBlog(id, title string) *blogpb.Blog {
+	return &blogpb.Blog{Id: id, AuthorId: "author", Title: title, Revision: 1}
+}
+
+// blogIDs returns the ids of the blogs of the store that are not in the trash
+func blogIDs(t *testing.T, store BlogStore) []string {
+	t.Helper()
+
+	ids := make([]string, 0)
+	err := store.ListBlogs(context.Background(), blogFilter{}, func(blog *blogpb.Blog) error {
+		ids = append(ids, blog.GetId())
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("ListBlogs: %v", err)
+	}
+	return ids
+}
+
+func fileSize(t *testing.T, path string) int64 {
+	t.Helper()
+
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatalf("Stat: %v", err)
+	}
+	return info.Size()
+}
+
+func appendToFile(t *testing.T, path string, b []byte) {
+	t.Helper()
+
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		t.Fatalf("OpenFile: %v", err)
+	}
+	defer file.Close()
+	_, err = file.Write(b)
+	if err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+}
+
+func TestFileStoreReplayTornTail(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "blogs.log")
+
+	f := openTestFileStore(t, path)
+	for _, id := range []string{"a", "b"} {
+		_, err := f.CreateBlog(ctx, testBlog(id, "blog "+id))
+		if err != nil {
+			t.Fatalf("CreateBlog: %v", err)
+		}
+	}
+	f.Close(ctx)
+	validSize := fileSize(t, path)
+
+	//the server crashed in the middle of the write of the blog c
+	record, err := encodeRecord(opPutBlog, testBlog("c", "blog c"))
+	if err != nil {
+		t.Fatalf("encodeRecord: %v", err)
+	}
+	appendToFile(t, path, record[:len(record)-3])
+
+	f = openTestFileStore(t, path)
+	if ids := blogIDs(t, f); len(ids) != 2 {
+		t.Fatalf("the replay should keep the 2 complete blogs, got %v", ids)
+	}
+	if size := fileSize(t, path); size != validSize {
+		t.Fatalf("the torn record should be truncated to %v bytes, the file has %v", validSize, size)
+	}
+
+	//the next write goes right after the last valid record, so it is replayed too
+	_, err = f.CreateBlog(ctx, testBlog("d", "blog d"))
+	if err != nil {
+		t.Fatalf("CreateBlog: %v", err)
+	}
+	f.Close(ctx)
+
+	f = openTestFileStore(t, path)
+	if ids := blogIDs(t, f); len(ids) != 3 || ids[2] != "d" {
+		t.Errorf("the blog written after the truncate should be replayed, got %v", ids)
+	}
+	f.Close(ctx)
+	validSize = fileSize(t, path)
+
+	//the crash can also happen in the middle of the header
+	appendToFile(t, path, record[:recordHeaderSize-3])
+	f = openTestFileStore(t, path)
+	defer f.Close(ctx)
+	if size := fileSize(t, path); size != validSize || len(blogIDs(t, f)) != 3 {
+		t.Errorf("the torn header should be truncated to %v bytes, the file has %v", validSize, size)
+	}
+}
+
+// checkReplayFails checks that the store doesn't open because of the bad record at the offset, without changing the file
+func checkReplayFails(t *testing.T, path string, offset int64) {
+	t.Helper()
+
+	size := fileSize(t, path)
+	f, err := newFileStore(path, 0)
+	if err == nil {
+		f.Close(context.Background())
+		t.Fatalf("newFileStore should fail with the bad record at offset %v", offset)
+	}
+	if !strings.Contains(err.Error(), fmt.Sprintf("offset %v:", offset)) {
+		t.Errorf("the error should have the offset %v of the bad record, got %v", offset, err)
+	}
+	if got := fileSize(t, path); got != size {
+		t.Errorf("the file with a bad record should not change, it had %v bytes and has %v", size, got)
+	}
+}
+
+func TestFileStoreReplayCorruptRecord(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "blogs.log")
+
+	f := openTestFileStore(t, path)
+	_, err := f.CreateBlog(ctx, testBlog("a", "blog a"))
+	if err != nil {
+		t.Fatalf("CreateBlog: %v", err)
+	}
+	validSize := fileSize(t, path)
+	_, err = f.CreateBlog(ctx, testBlog("b", "blog b"))
+	if err != nil {
+		t.Fatalf("CreateBlog: %v", err)
+	}
+	f.Close(ctx)
+
+	//a byte of the payload of the last record changes, so its checksum doesn't match
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile: %v", err)
+	}
+	b[len(b)-1] ^= 0xff
+	err = ioutil.WriteFile(path, b, 0644)
+	if err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	//the record is complete, so it wasn't a crash in the middle of the write and it is not truncated
+	checkReplayFails(t, path, validSize)
+}
+
+func TestFileStoreReplayUnknownRecord(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "blogs.log")
+
+	f := openTestFileStore(t, path)
+	_, err := f.CreateBlog(ctx, testBlog("a", "blog a"))
+	if err != nil {
+		t.Fatalf("CreateBlog: %v", err)
+	}
+	validSize := fileSize(t, path)
+	f.Close(ctx)
+
+	//the checksum is valid but the operation is unknown, like a record of a newer server
+	appendToFile(t, path, frameRecord(recordOp(99), []byte("data")))
+	checkReplayFails(t, path, validSize)
+}
+
+func TestFileStoreReplayBatch(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "blogs.log")
+
+	f := openTestFileStore(t, path)
+	err := f.WriteBlogs(ctx, []blogWrite{
+		{Blog: testBlog("a", "blog a"), Create: true},
+		{Blog: testBlog("b", "blog b"), Create: true},
+	})
+	if err != nil {
+		t.Fatalf("WriteBlogs: %v", err)
+	}
+	validSize := fileSize(t, path)
+	f.Close(ctx)
+
+	f = openTestFileStore(t, path)
+	if ids := blogIDs(t, f); len(ids) != 2 || ids[0] != "a" || ids[1] != "b" {
+		t.Errorf("the replay should have the blogs of the batch, got %v", ids)
+	}
+	f.Close(ctx)
+
+	//a batch with a valid checksum but a bad inner record must not be applied nor truncated
+	first, err := encodeRecord(opPutBlog, testBlog("c", "blog c"))
+	if err != nil {
+		t.Fatalf("encodeRecord: %v", err)
+	}
+	second, err := encodeRecord(opDeleteBlog, &blogpb.Blog{Id: "a"})
+	if err != nil {
+		t.Fatalf("encodeRecord: %v", err)
+	}
+	appendToFile(t, path, frameRecord(opBatch, append(first, second...)))
+	checkReplayFails(t, path, validSize)
+}
+
+func TestFileStoreCompactAndReopen(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "blogs.log")
+
+	f := openTestFileStore(t, path)
+	_, err := f.CreateAuthor(ctx, &blogpb.Author{Id: "author", Name: "Diego"})
+	if err != nil {
+		t.Fatalf("CreateAuthor: %v", err)
+	}
+	_, err = f.CreateBlog(ctx, testBlog("a", "first title"))
+	if err != nil {
+		t.Fatalf("CreateBlog: %v", err)
+	}
+	updated, err := f.UpdateBlog(ctx, testBlog("a", "second title"), 1)
+	if err != nil {
+		t.Fatalf("UpdateBlog: %v", err)
+	}
+	_, err = f.CreateBlog(ctx, testBlog("b", "in the trash"))
+	if err != nil {
+		t.Fatalf("CreateBlog: %v", err)
+	}
+	_, err = f.TrashBlog(ctx, "b", time.Now())
+	if err != nil {
+		t.Fatalf("TrashBlog: %v", err)
+	}
+	_, err = f.CreateBlog(ctx, testBlog("c", "removed"))
+	if err != nil {
+		t.Fatalf("CreateBlog: %v", err)
+	}
+	_, err = f.DeleteBlog(ctx, "c", true)
+	if err != nil {
+		t.Fatalf("DeleteBlog: %v", err)
+	}
+	comment, err := f.CreateComment(ctx, &blogpb.Comment{Id: "comment", BlogId: "a", AuthorId: "author", Content: "nice"})
+	if err != nil {
+		t.Fatalf("CreateComment: %v", err)
+	}
+	//each view is a record, so most of the log is dead and the compaction is worth it
+	for i := 0; i < 10; i++ {
+		_, err = f.RecordView(ctx, "a")
+		if err != nil {
+			t.Fatalf("RecordView: %v", err)
+		}
+	}
+
+	before := f.records
+	err = f.compact()
+	if err != nil {
+		t.Fatalf("compact: %v", err)
+	}
+	if f.records >= before {
+		t.Fatalf("the compaction should reduce the %v records, it has %v", before, f.records)
+	}
+
+	//the writes after the compaction go to the new log
+	_, err = f.CreateBlog(ctx, testBlog("d", "after the compaction"))
+	if err != nil {
+		t.Fatalf("CreateBlog: %v", err)
+	}
+	f.Close(ctx)
+
+	f = openTestFileStore(t, path)
+	defer f.Close(ctx)
+	if ids := blogIDs(t, f); len(ids) != 2 || ids[0] != "a" || ids[1] != "d" {
+		t.Errorf("the reopened store should have the blogs a and d, got %v", ids)
+	}
+	blog, err := f.ReadBlog(ctx, "a")
+	if err != nil || !proto.Equal(blog, updated) {
+		t.Errorf("ReadBlog returned %v (%v), want %v", blog, err, updated)
+	}
+	history, err := f.ReadBlogHistory(ctx, "a")
+	if err != nil || len(history) != 2 {
+		t.Errorf("the history should have the 2 revisions, got %v (%v)", history, err)
+	}
+	if _, err = f.RestoreBlog(ctx, "b"); err != nil {
+		t.Errorf("the blog b should still be in the trash, RestoreBlog returned %v", err)
+	}
+	views, err := f.ViewCounts(ctx)
+	if err != nil || views["a"] != 10 {
+		t.Errorf("the blog a should have 10 views, got %v (%v)", views, err)
+	}
+	if _, err = f.ReadAuthor(ctx, "author"); err != nil {
+		t.Errorf("ReadAuthor: %v", err)
+	}
+	comments := make([]*blogpb.Comment, 0)
+	err = f.ListComments(ctx, "a", "", 0, func(c *blogpb.Comment) error {
+		comments = append(comments, c)
+		return nil
+	})
+	if err != nil || len(comments) != 1 || !proto.Equal(comments[0], comment) {
+		t.Errorf("the blog a should have its comment, got %v (%v)", comments, err)
+	}
+}
@@ -0,0 +1,232 @@
+package main
+
+import (
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// The clients can send an idempotency key in the metadata of the mutating rpcs, so a request that is
+// retried after a network error is not executed twice: the server remembers the result of each key
+// and sends it again to a request with the same key, with the idempotent-replayed header.
+// The keys are kept only in memory, for the retention period of the server.
+
+const (
+	idempotencyKeyHeader    = "idempotency-key"
+	idempotentReplayHeader  = "idempotent-replayed"
+	maxIdempotencyKeyLength = 255
+	maxIdempotencyKeys      = 100000 //max number of results kept, the oldest ones are forgotten first
+)
+
+// idempotentMethods are the unary rpcs that accept an idempotency key, the other rpcs ignore it
+var idempotentMethods = map[string]bool{
+	"/blog.BlogService/CreateBlog":          true,
+	"/blog.BlogService/UpdateBlog":          true,
+	"/blog.BlogService/DeleteBlog":          true,
+	"/blog.BlogService/RestoreBlog":         true,
+	"/blog.BlogService/SubmitBlogForReview": true,
+	"/blog.BlogService/PublishBlog":         true,
+	"/blog.BlogService/ArchiveBlog":         true,
+	"/blog.BlogService/ReturnBlogToDraft":   true,
+	"/blog.BlogService/CreateAuthor":        true,
+	"/blog.BlogService/CreateComment":       true,
+	"/blog.BlogService/DeleteComment":       true,
+	"/blog.BlogService/RecordView":          true,
+	"/blog.BlogService/BatchWriteBlogs":     true,
+}
+
+// forgottenCodes are the errors that may not happen again, so a retry executes the request instead of receiving them
+var forgottenCodes = map[codes.Code]bool{
+	codes.Unknown:           true,
+	codes.Canceled:          true,
+	codes.DeadlineExceeded:  true,
+	codes.Unavailable:       true,
+	codes.ResourceExhausted: true,
+	codes.Aborted:           true,
+	codes.Internal:          true,
+}
+
+type idempotencyStore struct {
+	mu        sync.Mutex
+	retention time.Duration
+	entries   map[string]*idempotencyEntry //tenant, method and key -> result
+	finished  *list.List                   //finished entries, from the oldest to the newest
+}
+
+type idempotencyEntry struct {
+	key         string
+	fingerprint [sha256.Size]byte //hash of the request, the same key can't be used for another request
+	done        chan struct{}     //closed when the result is ready
+	response    proto.Message
+	err         error
+	forgotten   bool //the result was not kept, the request must be executed again
+	expiresAt   time.Time
+	element     *list.Element
+}
+
+func newIdempotencyStore(retention time.Duration) *idempotencyStore {
+	return &idempotencyStore{
+		retention: retention,
+		entries:   make(map[string]*idempotencyEntry),
+		finished:  list.New(),
+	}
+}
+
+// unaryInterceptor executes each request with an idempotency key only once, the retries receive the same result
+func (s *idempotencyStore) unaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+
+	if !idempotentMethods[info.FullMethod] {
+		return handler(ctx, req)
+	}
+	key, err := idempotencyKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if key == "" {
+		return handler(ctx, req)
+	}
+
+	fingerprint, err := requestFingerprint(req)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not read the request: %v", err)
+	}
+
+	//the same key can be used by other tenants
+	scope := info.FullMethod + " " + key
+	if t := tenantFromContext(ctx); t != nil {
+		scope = t.id + " " + scope
+	}
+
+	for {
+		entry, started := s.start(scope, fingerprint)
+		if started {
+			res, err := handler(ctx, req)
+			s.finish(entry, res, err)
+			return res, err
+		}
+
+		if entry.fingerprint != fingerprint {
+			return nil, status.Errorf(codes.InvalidArgument, "The %v was already used with a different request", idempotencyKeyHeader)
+		}
+
+		//the first request may still be running, we wait for its result
+		select {
+		case <-entry.done:
+		case <-ctx.Done():
+			return nil, storeError(ctx.Err(), "")
+		}
+		if entry.forgotten {
+			continue
+		}
+
+		grpc.SetHeader(ctx, metadata.Pairs(idempotentReplayHeader, "true"))
+		if entry.err != nil {
+			return nil, entry.err
+		}
+		return proto.Clone(entry.response), nil
+	}
+}
+
+// start returns the entry of the key, started is true when it is new and the caller must execute the request
+func (s *idempotencyStore) start(key string, fingerprint [sha256.Size]byte) (entry *idempotencyEntry, started bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.removeExpired(time.Now())
+
+	if entry, ok := s.entries[key]; ok {
+		return entry, false
+	}
+
+	entry = &idempotencyEntry{
+		key:         key,
+		fingerprint: fingerprint,
+		done:        make(chan struct{}),
+	}
+	s.entries[key] = entry
+
+	return entry, true
+}
+
+// finish saves the result of the request and wakes up the retries that are waiting for it
+func (s *idempotencyStore) finish(entry *idempotencyEntry, res interface{}, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	defer close(entry.done)
+
+	response, ok := res.(proto.Message)
+	if (err == nil && !ok) || (err != nil && forgottenCodes[status.Code(err)]) {
+		entry.forgotten = true
+		delete(s.entries, entry.key)
+		return
+	}
+
+	if err == nil {
+		entry.response = proto.Clone(response)
+	}
+	entry.err = err
+	entry.expiresAt = time.Now().Add(s.retention)
+	entry.element = s.finished.PushBack(entry)
+
+	if s.finished.Len() > maxIdempotencyKeys {
+		s.remove(s.finished.Front().Value.(*idempotencyEntry))
+	}
+}
+
+// removeExpired forgets the results older than the retention, s.mu must be held
+func (s *idempotencyStore) removeExpired(now time.Time) {
+	for front := s.finished.Front(); front != nil; front = s.finished.Front() {
+		entry := front.Value.(*idempotencyEntry)
+		if now.Before(entry.expiresAt) {
+			return
+		}
+		s.remove(entry)
+	}
+}
+
+func (s *idempotencyStore) remove(entry *idempotencyEntry) {
+	s.finished.Remove(entry.element)
+	delete(s.entries, entry.key)
+}
+
+// idempotencyKey returns the key sent in the request metadata, or an empty string if there is none
+func idempotencyKey(ctx context.Context) (string, error) {
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", nil
+	}
+	values := md.Get(idempotencyKeyHeader)
+	if len(values) == 0 {
+		return "", nil
+	}
+	if len(values) > 1 {
+		return "", status.Errorf(codes.InvalidArgument, "Only one %v can be sent", idempotencyKeyHeader)
+	}
+	if values[0] == "" || len(values[0]) > maxIdempotencyKeyLength {
+		return "", status.Errorf(codes.InvalidArgument, "The %v must have between 1 and %v bytes", idempotencyKeyHeader, maxIdempotencyKeyLength)
+	}
+
+	return values[0], nil
+}
+
+func requestFingerprint(req interface{}) ([sha256.Size]byte, error) {
+	message, ok := req.(proto.Message)
+	if !ok {
+		return [sha256.Size]byte{}, errors.New("the request is not a protobuf message")
+	}
+	b, err := proto.MarshalOptions{Deterministic: true}.Marshal(message)
+	if err != nil {
+		return [sha256.Size]byte{}, err
+	}
+	return sha256.Sum256(b), nil
+}
@@ -0,0 +1,163 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/diegoclair/grpc-go-course/blog/blogpb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+var createBlogInfo = &grpc.UnaryServerInfo{FullMethod: "/blog.BlogService/CreateBlog"}
+
+// countingHandler returns a handler that creates a blog with the number of the call as id, or fails with err
+func countingHandler(calls *int, err error) grpc.UnaryHandler {
+	return func(ctx context.Context, req interface{}) (interface{}, error) {
+		*calls++
+		if err != nil {
+			return nil, err
+		}
+		return &blogpb.CreateBlogResponse{Blog: &blogpb.Blog{Id: fmt.Sprint(*calls)}}, nil
+	}
+}
+
+func idempotentContext(key string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(idempotencyKeyHeader, key))
+}
+
+func createRequest(title string) *blogpb.CreateBlogRequest {
+	return &blogpb.CreateBlogRequest{Blog: &blogpb.Blog{AuthorId: "author", Title: title}}
+}
+
+func TestIdempotencyReplay(t *testing.T) {
+	s := newIdempotencyStore(time.Hour)
+	calls := 0
+	handler := countingHandler(&calls, nil)
+
+	first, err := s.unaryInterceptor(idempotentContext("key"), createRequest("title"), createBlogInfo, handler)
+	if err != nil {
+		t.Fatalf("first request: %v", err)
+	}
+	retry, err := s.unaryInterceptor(idempotentContext("key"), createRequest("title"), createBlogInfo, handler)
+	if err != nil {
+		t.Fatalf("retry: %v", err)
+	}
+	if calls != 1 || !proto.Equal(first.(proto.Message), retry.(proto.Message)) {
+		t.Errorf("the retry should receive the first result without a new call, got %v and %v after %v calls", first, retry, calls)
+	}
+
+	//another key, or no key at all, is a new request
+	_, err = s.unaryInterceptor(idempotentContext("other key"), createRequest("title"), createBlogInfo, handler)
+	if err != nil || calls != 2 {
+		t.Errorf("a request with another key should be executed, got %v after %v calls", err, calls)
+	}
+	_, err = s.unaryInterceptor(context.Background(), createRequest("title"), createBlogInfo, handler)
+	if err != nil || calls != 3 {
+		t.Errorf("a request without a key should be executed, got %v after %v calls", err, calls)
+	}
+
+	//the same key of another tenant is another request
+	ctx := context.WithValue(idempotentContext("key"), tenantContextKey{}, &tenant{id: "team-a"})
+	_, err = s.unaryInterceptor(ctx, createRequest("title"), createBlogInfo, handler)
+	if err != nil || calls != 4 {
+		t.Errorf("the key of another tenant should be executed, got %v after %v calls", err, calls)
+	}
+}
+
+func TestIdempotencyFingerprintMismatch(t *testing.T) {
+	s := newIdempotencyStore(time.Hour)
+	calls := 0
+	handler := countingHandler(&calls, nil)
+
+	_, err := s.unaryInterceptor(idempotentContext("key"), createRequest("title"), createBlogInfo, handler)
+	if err != nil {
+		t.Fatalf("first request: %v", err)
+	}
+	_, err = s.unaryInterceptor(idempotentContext("key"), createRequest("another title"), createBlogInfo, handler)
+	if status.Code(err) != codes.InvalidArgument || calls != 1 {
+		t.Errorf("the key used with another request should return INVALID_ARGUMENT without a call, got %v after %v calls", err, calls)
+	}
+}
+
+func TestIdempotencyErrors(t *testing.T) {
+	tests := []struct {
+		name      string
+		err       error
+		wantCalls int
+	}{
+		{"permanent error is replayed", status.Errorf(codes.FailedPrecondition, "The author doesn't exist"), 1},
+		{"transient error is executed again", status.Errorf(codes.Unavailable, "The storage is down"), 2},
+	}
+
+	for _, test := range tests {
+		s := newIdempotencyStore(time.Hour)
+		calls := 0
+		handler := countingHandler(&calls, test.err)
+
+		for i := 0; i < 2; i++ {
+			_, err := s.unaryInterceptor(idempotentContext("key"), createRequest("title"), createBlogInfo, handler)
+			if status.Code(err) != status.Code(test.err) {
+				t.Errorf("%v: got %v, want %v", test.name, err, test.err)
+			}
+		}
+		if calls != test.wantCalls {
+			t.Errorf("%v: the handler was called %v times, want %v", test.name, calls, test.wantCalls)
+		}
+	}
+}
+
+func TestIdempotencyExpiredKey(t *testing.T) {
+	s := newIdempotencyStore(time.Nanosecond)
+	calls := 0
+	handler := countingHandler(&calls, nil)
+
+	for i := 0; i < 2; i++ {
+		_, err := s.unaryInterceptor(idempotentContext("key"), createRequest("title"), createBlogInfo, handler)
+		if err != nil {
+			t.Fatalf("request %v: %v", i, err)
+		}
+		time.Sleep(time.Millisecond)
+	}
+	if calls != 2 {
+		t.Errorf("the key should be forgotten after the retention, the handler was called %v times", calls)
+	}
+}
+
+func TestIdempotencyConcurrentRetry(t *testing.T) {
+	s := newIdempotencyStore(time.Hour)
+	started := make(chan struct{})
+	release := make(chan struct{})
+	calls := 0
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		calls++
+		close(started)
+		<-release
+		return &blogpb.CreateBlogResponse{Blog: &blogpb.Blog{Id: "first"}}, nil
+	}
+
+	//the retry arrives while the first request is still running, so it waits for its result
+	firstDone := make(chan interface{})
+	go func() {
+		res, _ := s.unaryInterceptor(idempotentContext("key"), createRequest("title"), createBlogInfo, handler)
+		firstDone <- res
+	}()
+	<-started
+
+	retryDone := make(chan interface{})
+	go func() {
+		res, _ := s.unaryInterceptor(idempotentContext("key"), createRequest("title"), createBlogInfo, handler)
+		retryDone <- res
+	}()
+	close(release)
+
+	first, retry := <-firstDone, <-retryDone
+	if calls != 1 || retry.(*blogpb.CreateBlogResponse).GetBlog().GetId() != "first" || !proto.Equal(first.(proto.Message), retry.(proto.Message)) {
+		t.Errorf("the retry should receive the result of the running request, got %v and %v after %v calls", first, retry, calls)
+	}
+}
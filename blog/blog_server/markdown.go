@@ -0,0 +1,401 @@
+package main
+
+import (
+	"fmt"
+	"html"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/diegoclair/grpc-go-course/blog/blogpb"
+)
+
+// The markdown renderer supports the common subset of the syntax:
+//	blocks: # headings, paragraphs, ``` fenced code, - and 1. lists, > quotes and --- rules
+//	inline: **strong**, *emphasis*, `code`, [links](url) and ![images](url)
+// The output is sanitised because it is built only with our own tags: all the text of the content
+// is escaped, so a raw html in the markdown is shown as text, and only safe urls are used in links.
+
+const (
+	maxQuoteDepth  = 16 //deeper > quotes are rendered as text, each level is a recursion
+	maxInlineDepth = 16 //links and emphasis nested deeper than this are rendered as text
+)
+
+var (
+	headingRegexp     = regexp.MustCompile(`^(#{1,6})\s+(.*?)\s*#*\s*$`)
+	ruleRegexp        = regexp.MustCompile(`^ {0,3}((- *){3,}|(\* *){3,}|(_ *){3,})$`)
+	bulletItemRegexp  = regexp.MustCompile(`^ {0,3}[-*+]\s+(.*)$`)
+	orderedItemRegexp = regexp.MustCompile(`^ {0,3}\d{1,9}[.)]\s+(.*)$`)
+	fenceRegexp       = regexp.MustCompile("^ {0,3}(```+|~~~+)\\s*([\\w+-]*)")
+)
+
+type renderedMarkdown struct {
+	html string
+	toc  []*blogpb.TocEntry
+	text string // plain text of the paragraphs, lists and quotes, used for the excerpt
+}
+
+type markdownRenderer struct {
+	html        strings.Builder
+	text        strings.Builder
+	toc         []*blogpb.TocEntry
+	anchors     map[string]int // how many headings already use each anchor
+	quoteDepth  int
+	inlineDepth int
+}
+
+func renderMarkdown(source string) *renderedMarkdown {
+	r := &markdownRenderer{
+		anchors: make(map[string]int),
+	}
+	source = strings.ReplaceAll(source, "\r\n", "\n")
+	r.blocks(strings.Split(source, "\n"))
+
+	return &renderedMarkdown{
+		html: r.html.String(),
+		toc:  r.toc,
+		text: strings.Join(strings.Fields(r.text.String()), " "),
+	}
+}
+
+// blocks renders the lines as block elements
+func (r *markdownRenderer) blocks(lines []string) {
+
+	paragraph := make([]string, 0)
+	flush := func() {
+		if len(paragraph) > 0 {
+			r.html.WriteString("<p>")
+			r.html.WriteString(r.inline(strings.Join(paragraph, "\n")))
+			r.html.WriteString("</p>\n")
+			r.text.WriteString("\n")
+			paragraph = paragraph[:0]
+		}
+	}
+
+	for i := 0; i < len(lines); i++ {
+		line := lines[i]
+		trimmed := strings.TrimSpace(line)
+
+		switch {
+		case trimmed == "":
+			flush()
+
+		case fenceRegexp.MatchString(line):
+			flush()
+			i = r.codeBlock(lines, i)
+
+		case headingRegexp.MatchString(trimmed):
+			flush()
+			m := headingRegexp.FindStringSubmatch(trimmed)
+			r.heading(len(m[1]), m[2])
+
+		case ruleRegexp.MatchString(line):
+			flush()
+			r.html.WriteString("<hr>\n")
+
+		case strings.HasPrefix(trimmed, ">") && r.quoteDepth < maxQuoteDepth:
+			flush()
+			quote := make([]string, 0)
+			for ; i < len(lines) && strings.HasPrefix(strings.TrimSpace(lines[i]), ">"); i++ {
+				l := strings.TrimPrefix(strings.TrimSpace(lines[i]), ">")
+				quote = append(quote, strings.TrimPrefix(l, " "))
+			}
+			i--
+			r.html.WriteString("<blockquote>\n")
+			r.quoteDepth++
+			r.blocks(quote)
+			r.quoteDepth--
+			r.html.WriteString("</blockquote>\n")
+
+		case bulletItemRegexp.MatchString(line):
+			flush()
+			i = r.list(lines, i, bulletItemRegexp, "ul")
+
+		case orderedItemRegexp.MatchString(line):
+			flush()
+			i = r.list(lines, i, orderedItemRegexp, "ol")
+
+		default:
+			paragraph = append(paragraph, trimmed)
+		}
+	}
+	flush()
+}
+
+// codeBlock renders the fenced code that starts at lines[start] and returns the index of its last line
+func (r *markdownRenderer) codeBlock(lines []string, start int) int {
+
+	m := fenceRegexp.FindStringSubmatch(lines[start])
+	fence, language := m[1], m[2]
+
+	code := make([]string, 0)
+	end := start + 1
+	for ; end < len(lines); end++ {
+		if strings.HasPrefix(strings.TrimSpace(lines[end]), fence) {
+			break
+		}
+		code = append(code, lines[end])
+	}
+
+	if language != "" {
+		fmt.Fprintf(&r.html, `<pre><code class="language-%v">`, html.EscapeString(language))
+	} else {
+		r.html.WriteString("<pre><code>")
+	}
+	for _, l := range code {
+		r.html.WriteString(html.EscapeString(l))
+		r.html.WriteString("\n")
+	}
+	r.html.WriteString("</code></pre>\n")
+
+	return end //a block without the closing fence goes until the end of the content
+}
+
+func (r *markdownRenderer) heading(level int, text string) {
+
+	//the text of the headings is not in the excerpt, so we render it with its own builder
+	var plain strings.Builder
+	content := r.inlineTo(text, &plain)
+
+	//the repeated headings get a number, like "intro", "intro-1", "intro-2"
+	slug := slugify(plain.String())
+	anchor := slug
+	for n := r.anchors[slug]; r.anchors[anchor] > 0; n++ {
+		anchor = slug + "-" + strconv.Itoa(n)
+	}
+	if anchor != slug {
+		r.anchors[slug]++
+	}
+	r.anchors[anchor]++
+
+	r.toc = append(r.toc, &blogpb.TocEntry{
+		Level:  int32(level),
+		Title:  plain.String(),
+		Anchor: anchor,
+	})
+	fmt.Fprintf(&r.html, "<h%v id=\"%v\">%v</h%v>\n", level, anchor, content, level)
+}
+
+// list renders the items that match itemRegexp starting at lines[start], returning the index of the last line.
+// An indented line after an item is part of the item.
+func (r *markdownRenderer) list(lines []string, start int, itemRegexp *regexp.Regexp, tag string) int {
+
+	items := make([]string, 0)
+	i := start
+	for ; i < len(lines); i++ {
+		line := lines[i]
+		if m := itemRegexp.FindStringSubmatch(line); m != nil {
+			items = append(items, m[1])
+			continue
+		}
+		if strings.TrimSpace(line) != "" && (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) {
+			items[len(items)-1] += "\n" + strings.TrimSpace(line)
+			continue
+		}
+		break
+	}
+
+	fmt.Fprintf(&r.html, "<%v>\n", tag)
+	for _, item := range items {
+		r.html.WriteString("<li>")
+		r.html.WriteString(r.inline(item))
+		r.html.WriteString("</li>\n")
+		r.text.WriteString("\n")
+	}
+	fmt.Fprintf(&r.html, "</%v>\n", tag)
+
+	return i - 1
+}
+
+// inline renders the inline elements of the text, its plain text goes to the excerpt
+func (r *markdownRenderer) inline(text string) string {
+	return r.inlineTo(text, &r.text)
+}
+
+// inlineTo renders the inline elements of the text, writing its plain text to plain
+func (r *markdownRenderer) inlineTo(text string, plain *strings.Builder) string {
+
+	if r.inlineDepth >= maxInlineDepth {
+		plain.WriteString(text)
+		return html.EscapeString(text)
+	}
+	r.inlineDepth++
+	defer func() { r.inlineDepth-- }()
+
+	//the brackets are matched once, so looking for the end of each link doesn't scan the rest of the text again
+	pairs := matchPairs(text)
+
+	var b strings.Builder
+	for i := 0; i < len(text); {
+		c := text[i]
+		switch {
+		case c == '\\' && i+1 < len(text) && unicode.IsPunct(rune(text[i+1])):
+			b.WriteString(html.EscapeString(text[i+1 : i+2]))
+			plain.WriteByte(text[i+1])
+			i += 2
+			continue
+
+		case c == '`':
+			ticks := len(text[i:]) - len(strings.TrimLeft(text[i:], "`"))
+			delimiter := text[i : i+ticks]
+			if end := strings.Index(text[i+ticks:], delimiter); end >= 0 {
+				code := strings.TrimSpace(text[i+ticks : i+ticks+end])
+				b.WriteString("<code>")
+				b.WriteString(html.EscapeString(code))
+				b.WriteString("</code>")
+				plain.WriteString(code)
+				i += ticks + end + ticks
+				continue
+			}
+
+		case c == '!' && strings.HasPrefix(text[i+1:], "["):
+			if label, target, size, ok := parseLink(text, i+1, pairs); ok {
+				if isSafeURL(target, false) {
+					fmt.Fprintf(&b, `<img src="%v" alt="%v">`, html.EscapeString(target), html.EscapeString(label))
+				}
+				plain.WriteString(label)
+				i += 1 + size
+				continue
+			}
+
+		case c == '[':
+			if label, target, size, ok := parseLink(text, i, pairs); ok {
+				content := r.inlineTo(label, plain)
+				if isSafeURL(target, true) {
+					fmt.Fprintf(&b, `<a href="%v" rel="nofollow noopener">%v</a>`, html.EscapeString(target), content)
+				} else {
+					b.WriteString(content)
+				}
+				i += size
+				continue
+			}
+
+		case c == '*' || (c == '_' && !isWordByte(text, i-1)):
+			delimiter := string(c)
+			tag := "em"
+			if strings.HasPrefix(text[i:], delimiter+delimiter) {
+				delimiter += delimiter
+				tag = "strong"
+			}
+			inner := text[i+len(delimiter):]
+			end := strings.Index(inner, delimiter)
+			if end > 0 && !unicode.IsSpace(rune(inner[0])) && !unicode.IsSpace(rune(inner[end-1])) {
+				fmt.Fprintf(&b, "<%v>%v</%v>", tag, r.inlineTo(inner[:end], plain), tag)
+				i += len(delimiter) + end + len(delimiter)
+				continue
+			}
+		}
+
+		b.WriteString(html.EscapeString(text[i : i+1]))
+		plain.WriteByte(c)
+		i++
+	}
+
+	return b.String()
+}
+
+// isWordByte checks if text[i] is a letter or digit, so snake_case_names are not emphasis
+func isWordByte(text string, i int) bool {
+	if i < 0 {
+		return false
+	}
+	c := rune(text[i])
+	return c < 0x80 && (unicode.IsLetter(c) || unicode.IsDigit(c))
+}
+
+// matchPairs returns the index of the ] or ) that closes each [ or ( of the text, the other indexes are -1
+func matchPairs(text string) []int {
+
+	pairs := make([]int, len(text))
+	brackets := make([]int, 0)
+	parentheses := make([]int, 0)
+	for i := 0; i < len(text); i++ {
+		pairs[i] = -1
+		switch text[i] {
+		case '[':
+			brackets = append(brackets, i)
+		case '(':
+			parentheses = append(parentheses, i)
+		case ']':
+			if n := len(brackets); n > 0 {
+				pairs[brackets[n-1]] = i
+				brackets = brackets[:n-1]
+			}
+		case ')':
+			if n := len(parentheses); n > 0 {
+				pairs[parentheses[n-1]] = i
+				parentheses = parentheses[:n-1]
+			}
+		}
+	}
+
+	return pairs
+}
+
+// parseLink parses a [label](target) that starts at text[start], size is the number of bytes of it.
+// The url can have parentheses, like in a wikipedia link, so it ends at the ) that closes the (.
+func parseLink(text string, start int, pairs []int) (label, target string, size int, ok bool) {
+
+	closing := pairs[start]
+	if closing < 0 || closing+1 >= len(text) || text[closing+1] != '(' {
+		return "", "", 0, false
+	}
+	end := pairs[closing+1]
+	if end < 0 {
+		return "", "", 0, false
+	}
+
+	//a title after the url, like [a](url "title"), is ignored
+	fields := strings.Fields(text[closing+2 : end])
+	if len(fields) > 0 {
+		target = strings.Trim(fields[0], "<>")
+	}
+
+	return text[start+1 : closing], target, end + 1 - start, true
+}
+
+// isSafeURL accepts only the relative urls and the ones with a known scheme, so a javascript: url is never used
+func isSafeURL(target string, allowMailto bool) bool {
+
+	if target == "" {
+		return false
+	}
+	u, err := url.Parse(target)
+	if err != nil {
+		return false
+	}
+
+	switch u.Scheme {
+	case "", "http", "https":
+		return true
+	case "mailto":
+		return allowMailto
+	}
+	return false
+}
+
+// slugify returns the anchor of a heading, like "Getting Started!" -> "getting-started"
+func slugify(text string) string {
+
+	var b strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(text) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(r)
+			dash = false
+			continue
+		}
+		if (unicode.IsSpace(r) || r == '-' || r == '_') && !dash && b.Len() > 0 {
+			b.WriteByte('-')
+			dash = true
+		}
+	}
+
+	slug := strings.TrimSuffix(b.String(), "-")
+	if slug == "" {
+		return "section"
+	}
+	return slug
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRenderMarkdownSanitizes(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"javascript link", "[x](javascript:alert(1))", "<p>x</p>\n"},
+		{"javascript link in upper case", "[x](JavaScript:alert(1))", "<p>x</p>\n"},
+		{"javascript link after a space", "[x]( javascript:alert(1))", "<p>x</p>\n"},
+		{"vbscript link", "[x](vbscript:msgbox(1))", "<p>x</p>\n"},
+		{"data link", "[x](data:text/html;base64,PHNjcmlwdD4=)", "<p>x</p>\n"},
+		{"javascript image", "![x](javascript:alert(1))", "<p></p>\n"},
+		{"data image", "![x](data:image/png;base64,AAAA)", "<p></p>\n"},
+		{"mailto image", "![x](mailto:a@b.com)", "<p></p>\n"},
+		{"mailto link", "[mail](mailto:a@b.com)", `<p><a href="mailto:a@b.com" rel="nofollow noopener">mail</a></p>` + "\n"},
+		{"https link", "[ok](https://example.com/a?b=1&c=2)", `<p><a href="https://example.com/a?b=1&amp;c=2" rel="nofollow noopener">ok</a></p>` + "\n"},
+		{"raw script", "<script>alert(1)</script>", "<p>&lt;script&gt;alert(1)&lt;/script&gt;</p>\n"},
+		{"raw image", "<img src=x onerror=alert(1)>", "<p>&lt;img src=x onerror=alert(1)&gt;</p>\n"},
+		{"raw html in a heading", "# <i>title</i>", `<h1 id="ititlei">&lt;i&gt;title&lt;/i&gt;</h1>` + "\n"},
+		{"raw html in a quote", "> <b>", "<blockquote>\n<p>&lt;b&gt;</p>\n</blockquote>\n"},
+		{"raw html in code", "`<b>`", "<p><code>&lt;b&gt;</code></p>\n"},
+		{"raw html in a code block", "```\n<b>x</b>\n```", "<pre><code>&lt;b&gt;x&lt;/b&gt;\n</code></pre>\n"},
+		{"quote in the href", `[a](http://x.com/"onmouseover="alert(1))`, `<p><a href="http://x.com/&#34;onmouseover=&#34;alert(1)" rel="nofollow noopener">a</a></p>` + "\n"},
+		{"quote in the label", `[a"b](http://x.com)`, `<p><a href="http://x.com" rel="nofollow noopener">a&#34;b</a></p>` + "\n"},
+		{"quote in the alt", `![x" onerror="alert(1)](http://x.com/a.png)`, `<p><img src="http://x.com/a.png" alt="x&#34; onerror=&#34;alert(1)"></p>` + "\n"},
+	}
+
+	for _, test := range tests {
+		got := renderMarkdown(test.in).html
+		if got != test.want {
+			t.Errorf("%v: renderMarkdown(%q) = %q, want %q", test.name, test.in, got, test.want)
+		}
+	}
+}
+
+func TestRenderMarkdownDepthLimits(t *testing.T) {
+	//past the limits the markdown is escaped text, so the nesting can't grow the stack or the time
+	quotes := renderMarkdown(strings.Repeat("> ", 10000) + "deep").html
+	if strings.Count(quotes, "<blockquote>") != maxQuoteDepth {
+		t.Errorf("the quotes should be nested up to %v levels, got %v", maxQuoteDepth, strings.Count(quotes, "<blockquote>"))
+	}
+	if !strings.Contains(quotes, "&gt; deep") {
+		t.Errorf("the quotes past the limit should be text, got %q", quotes[len(quotes)-200:])
+	}
+
+	links := renderMarkdown(strings.Repeat("[", 20) + "x" + strings.Repeat("](http://x.com)", 20)).html
+	if strings.Count(links, "<a ") != maxInlineDepth {
+		t.Errorf("the links should be nested up to %v levels, got %v", maxInlineDepth, strings.Count(links, "<a "))
+	}
+
+	//every [ could start a link, the brackets are matched once instead of scanning the text for each one
+	brackets := renderMarkdown(strings.Repeat("[", 1<<20)).html
+	if !strings.HasPrefix(brackets, "<p>[[[") {
+		t.Errorf("the brackets should be text, got %q", brackets[:20])
+	}
+}
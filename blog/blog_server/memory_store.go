@@ -0,0 +1,524 @@
+package main
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/diegoclair/grpc-go-course/blog/blogpb"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// memoryStore keeps the blogs in a map, it is used for tests and local development
+type memoryStore struct {
+	mu      sync.RWMutex
+	blogs   map[string]*blogpb.Blog
+	history map[string][]*blogpb.Blog //previous revisions of each blog, from the oldest to the newest
+	views   map[string]int64          //blog id -> views
+
+	authors      map[string]*blogpb.Author
+	comments     map[string]*blogpb.Comment
+	blogComments map[string]map[string]struct{} //blog id -> ids of its comments
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{
+		blogs:        make(map[string]*blogpb.Blog),
+		history:      make(map[string][]*blogpb.Blog),
+		views:        make(map[string]int64),
+		authors:      make(map[string]*blogpb.Author),
+		comments:     make(map[string]*blogpb.Comment),
+		blogComments: make(map[string]map[string]struct{}),
+	}
+}
+
+func (m *memoryStore) CreateBlog(ctx context.Context, blog *blogpb.Blog) (*blogpb.Blog, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.blogs[blog.GetId()]; ok {
+		return nil, errBlogAlreadyExists
+	}
+
+	//we store a copy, so the caller can't change our data after the request
+	m.blogs[blog.GetId()] = proto.Clone(blog).(*blogpb.Blog)
+
+	return proto.Clone(blog).(*blogpb.Blog), nil
+}
+
+func (m *memoryStore) ReadBlog(ctx context.Context, blogID string) (*blogpb.Blog, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	blog, ok := m.activeBlog(blogID)
+	if !ok {
+		return nil, errBlogNotFound
+	}
+
+	return proto.Clone(blog).(*blogpb.Blog), nil
+}
+
+// activeBlog returns the blog if it exists and is not in the trash, m.mu must be held
+func (m *memoryStore) activeBlog(blogID string) (*blogpb.Blog, bool) {
+	blog, ok := m.blogs[blogID]
+	if !ok || blog.GetDeletedAt() != nil {
+		return nil, false
+	}
+	return blog, true
+}
+
+func (m *memoryStore) UpdateBlog(ctx context.Context, blog *blogpb.Blog, expectedRevision int64) (*blogpb.Blog, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	current, ok := m.activeBlog(blog.GetId())
+	if !ok {
+		return nil, errBlogNotFound
+	}
+	data, err := nextRevision(current, blog, expectedRevision)
+	if err != nil {
+		return nil, err
+	}
+	m.putBlog(data)
+
+	return proto.Clone(data).(*blogpb.Blog), nil
+}
+
+func (m *memoryStore) WriteBlogs(ctx context.Context, writes []blogWrite) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	err := m.checkWrites(writes)
+	if err != nil {
+		return err
+	}
+	for _, w := range writes {
+		m.putBlog(w.Blog)
+	}
+
+	return nil
+}
+
+// checkBlogWrites checks if all the writes can be saved, without saving them
+func (m *memoryStore) checkBlogWrites(writes []blogWrite) error {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.checkWrites(writes)
+}
+
+// checkWrites checks the writes in order, each one sees the blogs of the previous ones, m.mu must be held
+func (m *memoryStore) checkWrites(writes []blogWrite) error {
+	written := make(map[string]*blogpb.Blog)
+	for i, w := range writes {
+		current, ok := written[w.Blog.GetId()]
+		if !ok {
+			current = m.blogs[w.Blog.GetId()]
+		}
+
+		switch {
+		case w.Create && current != nil:
+			return &batchError{Index: i, Err: errBlogAlreadyExists}
+		case !w.Create && (current == nil || current.GetDeletedAt() != nil):
+			return &batchError{Index: i, Err: errBlogNotFound}
+		case !w.Create && current.GetRevision() != w.ExpectedRevision:
+			return &batchError{Index: i, Err: errRevisionConflict}
+		}
+		written[w.Blog.GetId()] = w.Blog
+	}
+
+	return nil
+}
+
+// restoreBlog saves the blog as it is, it is used to rebuild the memory from another source, like a log file
+func (m *memoryStore) restoreBlog(blog *blogpb.Blog) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.putBlog(blog)
+}
+
+// putBlog saves the blog as it is, if it is a new revision the stored one is moved to the history, m.mu must be held
+func (m *memoryStore) putBlog(blog *blogpb.Blog) {
+	if current, ok := m.blogs[blog.GetId()]; ok && current.GetRevision() != blog.GetRevision() {
+		m.history[blog.GetId()] = append(m.history[blog.GetId()], current)
+	}
+	m.blogs[blog.GetId()] = proto.Clone(blog).(*blogpb.Blog)
+}
+
+func (m *memoryStore) DeleteBlog(ctx context.Context, blogID string, cascade bool) (*blogpb.Blog, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	blog, ok := m.blogs[blogID]
+	if !ok {
+		return nil, errBlogNotFound
+	}
+	if len(m.blogComments[blogID]) > 0 && !cascade {
+		return nil, errBlogHasComments
+	}
+	m.removeBlog(blogID)
+
+	return blog, nil
+}
+
+// removeBlog removes the blog with its history and comments, m.mu must be held
+func (m *memoryStore) removeBlog(blogID string) {
+	for commentID := range m.blogComments[blogID] {
+		delete(m.comments, commentID)
+	}
+	delete(m.blogComments, blogID)
+	delete(m.blogs, blogID)
+	delete(m.history, blogID)
+	delete(m.views, blogID)
+}
+
+func (m *memoryStore) TrashBlog(ctx context.Context, blogID string, deletedAt time.Time) (*blogpb.Blog, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	blog, ok := m.activeBlog(blogID)
+	if !ok {
+		return nil, errBlogNotFound
+	}
+
+	data := proto.Clone(blog).(*blogpb.Blog)
+	data.DeletedAt = timestamppb.New(deletedAt)
+	m.putBlog(data)
+
+	return proto.Clone(data).(*blogpb.Blog), nil
+}
+
+func (m *memoryStore) RestoreBlog(ctx context.Context, blogID string) (*blogpb.Blog, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	blog, ok := m.blogs[blogID]
+	if !ok || blog.GetDeletedAt() == nil {
+		return nil, errBlogNotFound
+	}
+
+	data := proto.Clone(blog).(*blogpb.Blog)
+	data.DeletedAt = nil
+	m.putBlog(data)
+
+	return proto.Clone(data).(*blogpb.Blog), nil
+}
+
+func (m *memoryStore) PurgeBlogs(ctx context.Context, deletedBefore time.Time) ([]*blogpb.Blog, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	purged := make([]*blogpb.Blog, 0)
+	for blogID, blog := range m.blogs {
+		if blog.GetDeletedAt() == nil || !blog.GetDeletedAt().AsTime().Before(deletedBefore) {
+			continue
+		}
+		m.removeBlog(blogID)
+		purged = append(purged, blog)
+	}
+
+	return purged, nil
+}
+
+func (m *memoryStore) ListBlogs(ctx context.Context, filter blogFilter, fn func(blog *blogpb.Blog) error) error {
+
+	//we copy the blogs that match, so we don't hold the lock while the caller is streaming them
+	m.mu.RLock()
+	blogs := make([]*blogpb.Blog, 0)
+	for _, blog := range m.blogs {
+		if !filter.matches(blog) {
+			continue
+		}
+		blogs = append(blogs, proto.Clone(blog).(*blogpb.Blog))
+	}
+	m.mu.RUnlock()
+
+	sort.Slice(blogs, func(i, j int) bool {
+		return blogs[i].GetId() < blogs[j].GetId()
+	})
+	if filter.Limit > 0 && len(blogs) > filter.Limit {
+		blogs = blogs[:filter.Limit]
+	}
+
+	for _, blog := range blogs {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := fn(blog); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *memoryStore) CountTags(ctx context.Context, filter blogFilter) (map[string]int64, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	filter.AfterID = ""
+	counts := make(map[string]int64)
+	for _, blog := range m.blogs {
+		if !filter.matches(blog) {
+			continue
+		}
+		for _, tag := range blog.GetTags() {
+			counts[tag]++
+		}
+	}
+
+	return counts, nil
+}
+
+func (m *memoryStore) Usage(ctx context.Context) (blogUsage, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	usage := blogUsage{Blogs: int64(len(m.blogs))}
+	for _, blog := range m.blogs {
+		usage.ContentBytes += int64(len(blog.GetContent()))
+	}
+
+	return usage, nil
+}
+
+func (m *memoryStore) RecordView(ctx context.Context, blogID string) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.activeBlog(blogID); !ok {
+		return 0, errBlogNotFound
+	}
+	m.views[blogID]++
+
+	return m.views[blogID], nil
+}
+
+func (m *memoryStore) ViewCounts(ctx context.Context) (map[string]int64, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	counts := make(map[string]int64, len(m.views))
+	for blogID, views := range m.views {
+		counts[blogID] = views
+	}
+
+	return counts, nil
+}
+
+// blogViews returns the views of the blog, 0 if it has none
+func (m *memoryStore) blogViews(blogID string) int64 {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.views[blogID]
+}
+
+// restoreViews sets the views of the blog, it is used by the file store replay
+func (m *memoryStore) restoreViews(blogID string, views int64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.blogs[blogID]; ok {
+		m.views[blogID] = views
+	}
+}
+
+func (m *memoryStore) ReadBlogHistory(ctx context.Context, blogID string) ([]*blogpb.Blog, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	if _, ok := m.activeBlog(blogID); !ok {
+		return nil, errBlogNotFound
+	}
+
+	return m.revisions(blogID), nil
+}
+
+// blogRevisions returns the revisions of the blog even if it is in the trash, nil if the blog doesn't exist
+func (m *memoryStore) blogRevisions(blogID string) []*blogpb.Blog {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	if _, ok := m.blogs[blogID]; !ok {
+		return nil
+	}
+	return m.revisions(blogID)
+}
+
+// revisions returns copies of the history and the current blog, m.mu must be held
+func (m *memoryStore) revisions(blogID string) []*blogpb.Blog {
+	revisions := make([]*blogpb.Blog, 0, len(m.history[blogID])+1)
+	for _, revision := range m.history[blogID] {
+		revisions = append(revisions, proto.Clone(revision).(*blogpb.Blog))
+	}
+	revisions = append(revisions, proto.Clone(m.blogs[blogID]).(*blogpb.Blog))
+
+	return revisions
+}
+
+func (m *memoryStore) CreateAuthor(ctx context.Context, author *blogpb.Author) (*blogpb.Author, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.authors[author.GetId()]; ok {
+		return nil, errAuthorAlreadyExists
+	}
+	m.authors[author.GetId()] = proto.Clone(author).(*blogpb.Author)
+
+	return proto.Clone(author).(*blogpb.Author), nil
+}
+
+func (m *memoryStore) ReadAuthor(ctx context.Context, authorID string) (*blogpb.Author, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	author, ok := m.authors[authorID]
+	if !ok {
+		return nil, errAuthorNotFound
+	}
+
+	return proto.Clone(author).(*blogpb.Author), nil
+}
+
+func (m *memoryStore) ListAuthors(ctx context.Context, afterID string, limit int, fn func(author *blogpb.Author) error) error {
+
+	m.mu.RLock()
+	authors := make([]*blogpb.Author, 0)
+	for _, author := range m.authors {
+		if afterID != "" && author.GetId() <= afterID {
+			continue
+		}
+		authors = append(authors, proto.Clone(author).(*blogpb.Author))
+	}
+	m.mu.RUnlock()
+
+	sort.Slice(authors, func(i, j int) bool {
+		return authors[i].GetId() < authors[j].GetId()
+	})
+	if limit > 0 && len(authors) > limit {
+		authors = authors[:limit]
+	}
+
+	for _, author := range authors {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := fn(author); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *memoryStore) CreateComment(ctx context.Context, comment *blogpb.Comment) (*blogpb.Comment, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.activeBlog(comment.GetBlogId()); !ok {
+		return nil, errBlogNotFound
+	}
+	m.putComment(comment)
+
+	return proto.Clone(comment).(*blogpb.Comment), nil
+}
+
+// restoreComment saves the comment as it is, it is used to rebuild the memory from another source
+func (m *memoryStore) restoreComment(comment *blogpb.Comment) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.putComment(comment)
+}
+
+// putComment saves the comment, m.mu must be held
+func (m *memoryStore) putComment(comment *blogpb.Comment) {
+	m.comments[comment.GetId()] = proto.Clone(comment).(*blogpb.Comment)
+
+	ids, ok := m.blogComments[comment.GetBlogId()]
+	if !ok {
+		ids = make(map[string]struct{})
+		m.blogComments[comment.GetBlogId()] = ids
+	}
+	ids[comment.GetId()] = struct{}{}
+}
+
+func (m *memoryStore) ListComments(ctx context.Context, blogID, afterID string, limit int, fn func(comment *blogpb.Comment) error) error {
+
+	m.mu.RLock()
+	comments := make([]*blogpb.Comment, 0)
+	for commentID := range m.blogComments[blogID] {
+		if afterID != "" && commentID <= afterID {
+			continue
+		}
+		comments = append(comments, proto.Clone(m.comments[commentID]).(*blogpb.Comment))
+	}
+	m.mu.RUnlock()
+
+	sort.Slice(comments, func(i, j int) bool {
+		return comments[i].GetId() < comments[j].GetId()
+	})
+	if limit > 0 && len(comments) > limit {
+		comments = comments[:limit]
+	}
+
+	for _, comment := range comments {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := fn(comment); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// hasBlog checks if the blog exists, even if it is in the trash
+func (m *memoryStore) hasBlog(blogID string) bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	_, ok := m.blogs[blogID]
+	return ok
+}
+
+func (m *memoryStore) hasComment(commentID string) bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	_, ok := m.comments[commentID]
+	return ok
+}
+
+func (m *memoryStore) hasComments(blogID string) bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return len(m.blogComments[blogID]) > 0
+}
+
+func (m *memoryStore) DeleteComment(ctx context.Context, commentID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	comment, ok := m.comments[commentID]
+	if !ok {
+		return errCommentNotFound
+	}
+	delete(m.comments, commentID)
+	delete(m.blogComments[comment.GetBlogId()], commentID)
+	if len(m.blogComments[comment.GetBlogId()]) == 0 {
+		delete(m.blogComments, comment.GetBlogId())
+	}
+
+	return nil
+}
+
+func (m *memoryStore) Close(ctx context.Context) error {
+	return nil
+}
@@ -0,0 +1,255 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// The migrations change the saved data when the Blog message changes, like a new field that needs a value in the
+// old documents, and create the indexes used by the queries. They run in order and each one runs only once,
+// the applied versions are saved in the schema_migrations collection of each database.
+// A new migration goes at the end of mongoMigrations with the next version, an applied one is never changed.
+//
+// The server applies the pending migrations when it starts, unless -migrate=false, and they can also be applied
+// before the deploy with the migrate command:
+//
+//	blog_server -storage mongo migrate -dry-run
+//	blog_server -storage mongo migrate
+
+const migrationsCollection = "schema_migrations"
+
+// mongoMigration is a version of the mongo schema, its steps must be safe to run again,
+// because two servers starting together can apply the same migration
+type mongoMigration struct {
+	Version     int
+	Description string
+	Steps       []migrationStep
+}
+
+// migrationStep changes the database, with dryRun it only returns what it would do
+type migrationStep func(ctx context.Context, db *mongo.Database, dryRun bool) (string, error)
+
+type migrationItem struct {
+	Version     int       `bson:"_id"`
+	Description string    `bson:"description"`
+	AppliedAt   time.Time `bson:"applied_at"`
+}
+
+var mongoMigrations = []mongoMigration{
+	{
+		Version:     1,
+		Description: "index the blogs by author, tags and category",
+		Steps: []migrationStep{
+			createIndex("blog", "author_id", bson.D{{Key: "author_id", Value: 1}, {Key: "_id", Value: 1}}, nil),
+			createIndex("blog", "tags", bson.D{{Key: "tags", Value: 1}}, nil),
+			createIndex("blog", "category", bson.D{{Key: "category", Value: 1}}, nil),
+			createIndex("blog", "deleted_at", bson.D{{Key: "deleted_at", Value: 1}}, nil),
+		},
+	},
+	{
+		Version:     2,
+		Description: "index the title and content of the blogs for the text search",
+		Steps: []migrationStep{
+			//a collection can have only one text index, the title words are worth more like in the search index of the server
+			createIndex("blog", "text", bson.D{{Key: "title", Value: "text"}, {Key: "content", Value: "text"}},
+				options.Index().SetWeights(bson.M{"title": 3, "content": 1}).SetDefaultLanguage("none")),
+		},
+	},
+	{
+		Version:     3,
+		Description: "index the history and the comments by blog",
+		Steps: []migrationStep{
+			createIndex("blog_history", "blog_id", bson.D{{Key: "blog_id", Value: 1}, {Key: "blog.revision", Value: 1}}, nil),
+			createIndex("comment", "blog_id", bson.D{{Key: "blog_id", Value: 1}, {Key: "_id", Value: 1}}, nil),
+		},
+	},
+	{
+		Version:     4,
+		Description: "fill the fields of the blogs saved before the trash, the tags and the workflow existed",
+		Steps: []migrationStep{
+			updateBlogs("without deleted_at", bson.M{"deleted_at": bson.M{"$exists": false}}, bson.M{"deleted_at": nil}),
+			updateBlogs("without tags", bson.M{"tags": nil}, bson.M{"tags": bson.A{}}),
+			//a missing status didn't match the query of the published blogs, 0 is still read as published
+			updateBlogs("without status", bson.M{"status": bson.M{"$exists": false}}, bson.M{"status": int32(0)}),
+		},
+	},
+}
+
+// createIndex returns a step that creates the index, creating an index that already exists does nothing
+func createIndex(collection, name string, keys bson.D, opts *options.IndexOptions) migrationStep {
+
+	if opts == nil {
+		opts = options.Index()
+	}
+	name = collection + "_" + name
+	opts.SetName(name)
+
+	return func(ctx context.Context, db *mongo.Database, dryRun bool) (string, error) {
+
+		if dryRun {
+			return fmt.Sprintf("would create the index %v on %v", name, collection), nil
+		}
+
+		_, err := db.Collection(collection).Indexes().CreateOne(ctx, mongo.IndexModel{Keys: keys, Options: opts})
+		if err != nil {
+			return "", fmt.Errorf("creating the index %v: %w", name, err)
+		}
+		return fmt.Sprintf("created the index %v on %v", name, collection), nil
+	}
+}
+
+// updateBlogs returns a step that sets the fields of the blogs that match the filter
+func updateBlogs(description string, filter, set bson.M) migrationStep {
+	return func(ctx context.Context, db *mongo.Database, dryRun bool) (string, error) {
+
+		if dryRun {
+			count, err := db.Collection("blog").CountDocuments(ctx, filter)
+			if err != nil {
+				return "", err
+			}
+			return fmt.Sprintf("would update %v blogs %v", count, description), nil
+		}
+
+		res, err := db.Collection("blog").UpdateMany(ctx, filter, bson.M{"$set": set})
+		if err != nil {
+			return "", fmt.Errorf("updating the blogs %v: %w", description, err)
+		}
+		return fmt.Sprintf("updated %v blogs %v", res.ModifiedCount, description), nil
+	}
+}
+
+func (m *mongoStore) Migrate(ctx context.Context, dryRun bool, out io.Writer) (int, error) {
+
+	db := m.collection.Database()
+	records := db.Collection(migrationsCollection)
+
+	applied := make(map[int]bool)
+	err := m.find(ctx, records, bson.M{}, 0, func(cursor *mongo.Cursor) error {
+		item := &migrationItem{}
+		err := cursor.Decode(item)
+		if err != nil {
+			return err
+		}
+		applied[item.Version] = true
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	//a newer server can have applied migrations that we don't know, their data could be misread by this version
+	latest := mongoMigrations[len(mongoMigrations)-1].Version
+	for version := range applied {
+		if version > latest {
+			return 0, fmt.Errorf("the database %v has the migration %v, but this server only knows up to %v", db.Name(), version, latest)
+		}
+	}
+
+	pending := 0
+	for _, migration := range mongoMigrations {
+		if applied[migration.Version] {
+			continue
+		}
+		pending++
+
+		fmt.Fprintf(out, "Migration %v of %v: %v\n", migration.Version, db.Name(), migration.Description)
+		for _, step := range migration.Steps {
+			result, err := step(ctx, db, dryRun)
+			if err != nil {
+				return pending - 1, fmt.Errorf("migration %v: %w", migration.Version, err)
+			}
+			fmt.Fprintf(out, "  %v\n", result)
+		}
+		if dryRun {
+			continue
+		}
+
+		//the version is saved after the steps, so a migration that fails in the middle runs again from the start
+		item := &migrationItem{Version: migration.Version, Description: migration.Description, AppliedAt: time.Now().UTC()}
+		_, err := records.InsertOne(ctx, item)
+		if err != nil && !isDuplicateKeyError(err) {
+			return pending - 1, fmt.Errorf("saving the migration %v: %w", migration.Version, err)
+		}
+	}
+
+	if pending == 0 {
+		fmt.Fprintf(out, "The database %v is at the migration %v\n", db.Name(), latest)
+	}
+
+	return pending, nil
+}
+
+// migrateStore applies the pending migrations of the store, the stores without a schema have nothing to migrate
+func migrateStore(ctx context.Context, store BlogStore, dryRun bool, out io.Writer) (int, error) {
+
+	s, ok := store.(schemaStore)
+	if !ok {
+		fmt.Fprintf(out, "The %v storage has no migrations\n", *storage)
+		return 0, nil
+	}
+	return s.Migrate(ctx, dryRun, out)
+}
+
+// startMigrations runs when the tenant starts, before its blogs are loaded by the search index
+func startMigrations(ctx context.Context, store BlogStore, tenantID string) error {
+
+	if _, ok := store.(schemaStore); !ok {
+		return nil
+	}
+	if *migrateOnStart {
+		_, err := migrateStore(ctx, store, false, os.Stdout)
+		return err
+	}
+
+	pending, err := migrateStore(ctx, store, true, ioutil.Discard)
+	if err != nil {
+		return err
+	}
+	if pending > 0 {
+		log.Printf("The tenant %v has %v pending migrations, apply them with the migrate command", tenantID, pending)
+	}
+	return nil
+}
+
+// migrateCommand applies or only shows the pending migrations of all the tenants, without starting the server
+func migrateCommand(configs []tenantConfig, args []string) error {
+
+	fs := flag.NewFlagSet("migrate", flag.ExitOnError)
+	dryRun := fs.Bool("dry-run", false, "shows the pending migrations without applying them")
+	fs.Parse(args)
+	if fs.NArg() > 0 {
+		return fmt.Errorf("unexpected arguments: %v", fs.Args())
+	}
+
+	ctx := context.Background()
+	for _, config := range configs {
+		connectCtx, cancel := context.WithTimeout(ctx, *connectTimeout)
+		store, err := newStore(connectCtx, *storage, config.ID)
+		cancel()
+		if err != nil {
+			return fmt.Errorf("tenant %v: %w", config.ID, err)
+		}
+
+		fmt.Printf("Tenant %v:\n", config.ID)
+		pending, err := migrateStore(ctx, store, *dryRun, os.Stdout)
+		store.Close(ctx)
+		if err != nil {
+			return fmt.Errorf("tenant %v: %w", config.ID, err)
+		}
+		if *dryRun && pending > 0 {
+			fmt.Printf("%v migrations would be applied\n", pending)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,85 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// migratingStore is a memory store with migrations, it keeps the dryRun of each call
+type migratingStore struct {
+	*memoryStore
+	pending int
+	calls   []bool
+	err     error
+}
+
+func (s *migratingStore) Migrate(ctx context.Context, dryRun bool, out io.Writer) (int, error) {
+	s.calls = append(s.calls, dryRun)
+	return s.pending, s.err
+}
+
+func TestMongoMigrationsOrder(t *testing.T) {
+	for i, migration := range mongoMigrations {
+		if migration.Version != i+1 {
+			t.Errorf("the migration %v should have the version %v, the versions must follow each other", migration.Description, i+1)
+		}
+		if migration.Description == "" || len(migration.Steps) == 0 {
+			t.Errorf("the migration %v should have a description and steps", migration.Version)
+		}
+	}
+}
+
+func TestCreateIndexDryRun(t *testing.T) {
+	step := createIndex("blog", "author_id", bson.D{{Key: "author_id", Value: 1}}, nil)
+
+	//the dry run doesn't touch the database, so it works without one
+	result, err := step(context.Background(), nil, true)
+	if err != nil || result != "would create the index blog_author_id on blog" {
+		t.Errorf("the dry run returned %q (%v)", result, err)
+	}
+}
+
+func TestStartMigrations(t *testing.T) {
+	ctx := context.Background()
+	defer func(migrate bool) { *migrateOnStart = migrate }(*migrateOnStart)
+
+	tests := []struct {
+		name    string
+		migrate bool
+		err     error
+		dryRun  bool
+	}{
+		{"applied on start", true, nil, false},
+		{"only reported", false, nil, true},
+		{"failed", true, errors.New("the database is down"), false},
+	}
+
+	for _, test := range tests {
+		*migrateOnStart = test.migrate
+		store := &migratingStore{memoryStore: newMemoryStore(), pending: 2, err: test.err}
+
+		err := startMigrations(ctx, store, "team-a")
+		if err != test.err {
+			t.Errorf("%v: startMigrations returned %v, want %v", test.name, err, test.err)
+		}
+		if len(store.calls) != 1 || store.calls[0] != test.dryRun {
+			t.Errorf("%v: Migrate was called with the dry runs %v, want [%v]", test.name, store.calls, test.dryRun)
+		}
+	}
+
+	//the stores without a schema are not migrated
+	if err := startMigrations(ctx, newMemoryStore(), "team-a"); err != nil {
+		t.Errorf("startMigrations of the memory store returned %v", err)
+	}
+	out := &bytes.Buffer{}
+	pending, err := migrateStore(ctx, newMemoryStore(), false, out)
+	if err != nil || pending != 0 || !strings.Contains(out.String(), "has no migrations") {
+		t.Errorf("migrateStore of the memory store returned %v (%v) and wrote %q", pending, err, out.String())
+	}
+}
@@ -0,0 +1,780 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/diegoclair/grpc-go-course/blog/blogpb"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.mongodb.org/mongo-driver/mongo/readpref"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	mongoDuplicateKeyCode = 11000
+)
+
+// blogItem is how the blog is saved in the mongo collection
+type blogItem struct {
+	ID          string     `bson:"_id"`
+	AuthorID    string     `bson:"author_id"`
+	Title       string     `bson:"title"`
+	Content     string     `bson:"content"`
+	Revision    int64      `bson:"revision"`
+	CreatedAt   time.Time  `bson:"created_at"`
+	UpdatedAt   time.Time  `bson:"updated_at"`
+	DeletedAt   *time.Time `bson:"deleted_at"` //nil when the blog is not in the trash
+	Tags        []string   `bson:"tags"`
+	Category    string     `bson:"category"`
+	Status      int32      `bson:"status"`
+	PublishAt   *time.Time `bson:"publish_at"`
+	PublishedAt *time.Time `bson:"published_at"`
+}
+
+// blogHistoryItem is a previous revision of a blog, saved in the history collection
+type blogHistoryItem struct {
+	ID     string    `bson:"_id"` //blog id and revision, so saving the same revision twice doesn't duplicate it
+	BlogID string    `bson:"blog_id"`
+	Blog   *blogItem `bson:"blog"`
+}
+
+type authorItem struct {
+	ID        string    `bson:"_id"`
+	Name      string    `bson:"name"`
+	Email     string    `bson:"email"`
+	CreatedAt time.Time `bson:"created_at"`
+}
+
+type commentItem struct {
+	ID        string    `bson:"_id"`
+	BlogID    string    `bson:"blog_id"`
+	AuthorID  string    `bson:"author_id"`
+	Content   string    `bson:"content"`
+	CreatedAt time.Time `bson:"created_at"`
+}
+
+// viewsItem has the views of a blog, with the same id of the blog
+type viewsItem struct {
+	BlogID string `bson:"_id"`
+	Views  int64  `bson:"views"`
+}
+
+func blogItemFromProto(blog *blogpb.Blog) *blogItem {
+	return &blogItem{
+		ID:          blog.GetId(),
+		AuthorID:    blog.GetAuthorId(),
+		Title:       blog.GetTitle(),
+		Content:     blog.GetContent(),
+		Revision:    blog.GetRevision(),
+		CreatedAt:   timeFromProto(blog.GetCreatedAt()),
+		UpdatedAt:   timeFromProto(blog.GetUpdatedAt()),
+		DeletedAt:   optionalTimeFromProto(blog.GetDeletedAt()),
+		Tags:        blog.GetTags(),
+		Category:    blog.GetCategory(),
+		Status:      int32(blog.GetStatus()),
+		PublishAt:   optionalTimeFromProto(blog.GetPublishAt()),
+		PublishedAt: optionalTimeFromProto(blog.GetPublishedAt()),
+	}
+}
+
+func (b *blogItem) toProto() *blogpb.Blog {
+	return &blogpb.Blog{
+		Id:          b.ID,
+		AuthorId:    b.AuthorID,
+		Title:       b.Title,
+		Content:     b.Content,
+		Revision:    b.Revision,
+		CreatedAt:   timeToProto(b.CreatedAt),
+		UpdatedAt:   timeToProto(b.UpdatedAt),
+		DeletedAt:   optionalTimeToProto(b.DeletedAt),
+		Tags:        b.Tags,
+		Category:    b.Category,
+		Status:      blogpb.BlogStatus(b.Status),
+		PublishAt:   optionalTimeToProto(b.PublishAt),
+		PublishedAt: optionalTimeToProto(b.PublishedAt),
+	}
+}
+
+func authorItemFromProto(author *blogpb.Author) *authorItem {
+	return &authorItem{
+		ID:        author.GetId(),
+		Name:      author.GetName(),
+		Email:     author.GetEmail(),
+		CreatedAt: timeFromProto(author.GetCreatedAt()),
+	}
+}
+
+func (a *authorItem) toProto() *blogpb.Author {
+	return &blogpb.Author{
+		Id:        a.ID,
+		Name:      a.Name,
+		Email:     a.Email,
+		CreatedAt: timeToProto(a.CreatedAt),
+	}
+}
+
+func commentItemFromProto(comment *blogpb.Comment) *commentItem {
+	return &commentItem{
+		ID:        comment.GetId(),
+		BlogID:    comment.GetBlogId(),
+		AuthorID:  comment.GetAuthorId(),
+		Content:   comment.GetContent(),
+		CreatedAt: timeFromProto(comment.GetCreatedAt()),
+	}
+}
+
+func (c *commentItem) toProto() *blogpb.Comment {
+	return &blogpb.Comment{
+		Id:        c.ID,
+		BlogId:    c.BlogID,
+		AuthorId:  c.AuthorID,
+		Content:   c.Content,
+		CreatedAt: timeToProto(c.CreatedAt),
+	}
+}
+
+func timeFromProto(t *timestamppb.Timestamp) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+	return t.AsTime()
+}
+
+func timeToProto(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+func optionalTimeFromProto(t *timestamppb.Timestamp) *time.Time {
+	if t == nil {
+		return nil
+	}
+	v := t.AsTime()
+	return &v
+}
+
+func optionalTimeToProto(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
+
+// mongoStore saves the blogs in the mongo database provisioned by the docker-compose.yml
+type mongoStore struct {
+	client     *mongo.Client
+	collection *mongo.Collection
+	history    *mongo.Collection
+	authors    *mongo.Collection
+	comments   *mongo.Collection
+	views      *mongo.Collection
+
+	transactions bool //false when mongo is a standalone server, like the one of the docker-compose.yml
+}
+
+// newMongoStore connects to the database, the username and password are only used when the uri has no credentials
+func newMongoStore(ctx context.Context, uri, username, password, database string) (*mongoStore, error) {
+
+	opts := options.Client().ApplyURI(uri)
+	if opts.Auth == nil && username != "" {
+		opts.SetAuth(options.Credential{Username: username, Password: password})
+	}
+
+	client, err := mongo.Connect(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	//Connect doesn't fail if the database is down, so we ping it to be sure that it is reachable
+	err = client.Ping(ctx, readpref.Primary())
+	if err != nil {
+		client.Disconnect(ctx)
+		return nil, err
+	}
+
+	//only the replica sets and the sharded clusters (isdbgrid) support the transactions of WriteBlogs
+	var hello struct {
+		SetName string `bson:"setName"`
+		Msg     string `bson:"msg"`
+	}
+	err = client.Database("admin").RunCommand(ctx, bson.D{{Key: "isMaster", Value: 1}}).Decode(&hello)
+	if err != nil {
+		client.Disconnect(ctx)
+		return nil, err
+	}
+
+	return &mongoStore{
+		client:       client,
+		collection:   client.Database(database).Collection("blog"),
+		history:      client.Database(database).Collection("blog_history"),
+		authors:      client.Database(database).Collection("author"),
+		comments:     client.Database(database).Collection("comment"),
+		views:        client.Database(database).Collection("blog_views"),
+		transactions: hello.SetName != "" || hello.Msg == "isdbgrid",
+	}, nil
+}
+
+func (m *mongoStore) CreateBlog(ctx context.Context, blog *blogpb.Blog) (*blogpb.Blog, error) {
+
+	_, err := m.collection.InsertOne(ctx, blogItemFromProto(blog))
+	if err != nil {
+		if isDuplicateKeyError(err) {
+			return nil, errBlogAlreadyExists
+		}
+		return nil, err
+	}
+
+	return blog, nil
+}
+
+func (m *mongoStore) ReadBlog(ctx context.Context, blogID string) (*blogpb.Blog, error) {
+
+	//a null deleted_at also matches the blogs saved before the trash existed, that don't have the field
+	data := &blogItem{}
+	err := m.collection.FindOne(ctx, bson.M{"_id": blogID, "deleted_at": nil}).Decode(data)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, errBlogNotFound
+		}
+		return nil, err
+	}
+
+	return data.toProto(), nil
+}
+
+func (m *mongoStore) UpdateBlog(ctx context.Context, blog *blogpb.Blog, expectedRevision int64) (*blogpb.Blog, error) {
+
+	current, err := m.ReadBlog(ctx, blog.GetId())
+	if err != nil {
+		return nil, err
+	}
+	data, err := nextRevision(current, blog, expectedRevision)
+	if err != nil {
+		return nil, err
+	}
+
+	//the history is saved before the replace, if the replace fails we only have saved a revision that really existed
+	err = m.saveHistory(ctx, current)
+	if err != nil {
+		return nil, err
+	}
+
+	//the filter by revision guarantees that nobody has changed the blog after we read it
+	filter := bson.M{"_id": data.GetId(), "revision": expectedRevision, "deleted_at": nil}
+	res, err := m.collection.ReplaceOne(ctx, filter, blogItemFromProto(data))
+	if err != nil {
+		return nil, err
+	}
+	if res.MatchedCount == 0 {
+		return nil, errRevisionConflict
+	}
+
+	return data, nil
+}
+
+func (m *mongoStore) saveHistory(ctx context.Context, blog *blogpb.Blog) error {
+
+	item := &blogHistoryItem{
+		ID:     fmt.Sprintf("%v:%v", blog.GetId(), blog.GetRevision()),
+		BlogID: blog.GetId(),
+		Blog:   blogItemFromProto(blog),
+	}
+	opts := options.Replace().SetUpsert(true)
+	_, err := m.history.ReplaceOne(ctx, bson.M{"_id": item.ID}, item, opts)
+
+	return err
+}
+
+// WriteBlogs uses a transaction, so mongo must run as a replica set, with a standalone server it returns errTransactionsUnsupported.
+// The history collection must already exist with mongo 4.2 or older, they can't create a collection in a transaction.
+func (m *mongoStore) WriteBlogs(ctx context.Context, writes []blogWrite) error {
+
+	if !m.transactions {
+		return errTransactionsUnsupported
+	}
+
+	session, err := m.client.StartSession()
+	if err != nil {
+		return err
+	}
+	defer session.EndSession(ctx)
+
+	//WithTransaction retries the whole function when mongo reports a transient error, like a write conflict
+	_, err = session.WithTransaction(ctx, func(sc mongo.SessionContext) (interface{}, error) {
+		for i, w := range writes {
+			err := m.writeBlog(sc, w)
+			if err != nil {
+				if errors.Is(err, errBlogAlreadyExists) || errors.Is(err, errBlogNotFound) || errors.Is(err, errRevisionConflict) {
+					return nil, &batchError{Index: i, Err: err}
+				}
+				return nil, err
+			}
+		}
+		return nil, nil
+	})
+
+	return err
+}
+
+// writeBlog saves one write of a batch, ctx must be the context of the transaction
+func (m *mongoStore) writeBlog(ctx context.Context, w blogWrite) error {
+
+	if w.Create {
+		_, err := m.CreateBlog(ctx, w.Blog)
+		return err
+	}
+
+	current, err := m.ReadBlog(ctx, w.Blog.GetId())
+	if err != nil {
+		return err
+	}
+	if current.GetRevision() != w.ExpectedRevision {
+		return errRevisionConflict
+	}
+	if current.GetRevision() != w.Blog.GetRevision() {
+		err = m.saveHistory(ctx, current)
+		if err != nil {
+			return err
+		}
+	}
+
+	filter := bson.M{"_id": w.Blog.GetId(), "revision": w.ExpectedRevision, "deleted_at": nil}
+	res, err := m.collection.ReplaceOne(ctx, filter, blogItemFromProto(w.Blog))
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return errRevisionConflict
+	}
+
+	return nil
+}
+
+func (m *mongoStore) ReadBlogHistory(ctx context.Context, blogID string) ([]*blogpb.Blog, error) {
+
+	current, err := m.ReadBlog(ctx, blogID)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := options.Find().SetSort(bson.M{"blog.revision": 1})
+	cursor, err := m.history.Find(ctx, bson.M{"blog_id": blogID, "blog.revision": bson.M{"$lt": current.GetRevision()}}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	revisions := make([]*blogpb.Blog, 0)
+	for cursor.Next(ctx) {
+		item := &blogHistoryItem{}
+		err := cursor.Decode(item)
+		if err != nil {
+			return nil, err
+		}
+		revisions = append(revisions, item.Blog.toProto())
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+
+	return append(revisions, current), nil
+}
+
+func (m *mongoStore) DeleteBlog(ctx context.Context, blogID string, cascade bool) (*blogpb.Blog, error) {
+
+	if !cascade {
+		count, err := m.comments.CountDocuments(ctx, bson.M{"blog_id": blogID}, options.Count().SetLimit(1))
+		if err != nil {
+			return nil, err
+		}
+		if count > 0 {
+			return nil, errBlogHasComments
+		}
+	}
+
+	data := &blogItem{}
+	err := m.collection.FindOneAndDelete(ctx, bson.M{"_id": blogID}).Decode(data)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, errBlogNotFound
+		}
+		return nil, err
+	}
+
+	_, err = m.history.DeleteMany(ctx, bson.M{"blog_id": blogID})
+	if err != nil {
+		return nil, err
+	}
+
+	//without cascade we have checked that there are no comments, but one could be created after the check
+	_, err = m.comments.DeleteMany(ctx, bson.M{"blog_id": blogID})
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = m.views.DeleteOne(ctx, bson.M{"_id": blogID})
+	if err != nil {
+		return nil, err
+	}
+
+	return data.toProto(), nil
+}
+
+func (m *mongoStore) TrashBlog(ctx context.Context, blogID string, deletedAt time.Time) (*blogpb.Blog, error) {
+	return m.setDeletedAt(ctx, bson.M{"_id": blogID, "deleted_at": nil}, &deletedAt)
+}
+
+func (m *mongoStore) RestoreBlog(ctx context.Context, blogID string) (*blogpb.Blog, error) {
+	return m.setDeletedAt(ctx, bson.M{"_id": blogID, "deleted_at": bson.M{"$ne": nil}}, nil)
+}
+
+// setDeletedAt changes the deleted_at of the blog that matches the filter, keeping its revision
+func (m *mongoStore) setDeletedAt(ctx context.Context, filter bson.M, deletedAt *time.Time) (*blogpb.Blog, error) {
+
+	data := &blogItem{}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	err := m.collection.FindOneAndUpdate(ctx, filter, bson.M{"$set": bson.M{"deleted_at": deletedAt}}, opts).Decode(data)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, errBlogNotFound
+		}
+		return nil, err
+	}
+
+	return data.toProto(), nil
+}
+
+func (m *mongoStore) PurgeBlogs(ctx context.Context, deletedBefore time.Time) ([]*blogpb.Blog, error) {
+
+	query := bson.M{"deleted_at": bson.M{"$lt": deletedBefore}}
+	expired := make([]string, 0)
+	err := m.find(ctx, m.collection, query, 0, func(cursor *mongo.Cursor) error {
+		expired = append(expired, cursor.Current.Lookup("_id").StringValue())
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	//the blog could have been restored after the find, so the delete checks the condition again
+	purged := make([]*blogpb.Blog, 0, len(expired))
+	for _, blogID := range expired {
+		data := &blogItem{}
+		err := m.collection.FindOneAndDelete(ctx, bson.M{"_id": blogID, "deleted_at": bson.M{"$lt": deletedBefore}}).Decode(data)
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			continue
+		}
+		if err != nil {
+			return purged, err
+		}
+
+		_, err = m.history.DeleteMany(ctx, bson.M{"blog_id": blogID})
+		if err != nil {
+			return purged, err
+		}
+		_, err = m.comments.DeleteMany(ctx, bson.M{"blog_id": blogID})
+		if err != nil {
+			return purged, err
+		}
+		_, err = m.views.DeleteOne(ctx, bson.M{"_id": blogID})
+		if err != nil {
+			return purged, err
+		}
+		purged = append(purged, data.toProto())
+	}
+
+	return purged, nil
+}
+
+func (m *mongoStore) ListBlogs(ctx context.Context, filter blogFilter, fn func(blog *blogpb.Blog) error) error {
+
+	query := blogQuery(filter)
+	if filter.AfterID != "" {
+		query["_id"] = bson.M{"$gt": filter.AfterID}
+	}
+
+	return m.find(ctx, m.collection, query, filter.Limit, func(cursor *mongo.Cursor) error {
+		data := &blogItem{}
+		err := cursor.Decode(data)
+		if err != nil {
+			return err
+		}
+		return fn(data.toProto())
+	})
+}
+
+// blogQuery returns the mongo query of the filter, without the AfterID
+func blogQuery(filter blogFilter) bson.M {
+
+	query := bson.M{"deleted_at": nil}
+	if filter.Deleted {
+		query["deleted_at"] = bson.M{"$ne": nil}
+	}
+	if filter.AuthorID != "" {
+		query["author_id"] = filter.AuthorID
+	}
+	if filter.Category != "" {
+		query["category"] = filter.Category
+	}
+	if filter.Status != blogpb.BlogStatus_BLOG_STATUS_UNSPECIFIED {
+		query["status"] = int32(filter.Status)
+		if filter.Status == blogpb.BlogStatus_BLOG_STATUS_PUBLISHED {
+			//the blogs saved before the workflow existed don't have a status
+			query["status"] = bson.M{"$in": []int32{int32(blogpb.BlogStatus_BLOG_STATUS_UNSPECIFIED), int32(filter.Status)}}
+		}
+	}
+	if len(filter.Tags) > 0 {
+		operator := "$all"
+		if filter.AnyTag {
+			operator = "$in"
+		}
+		query["tags"] = bson.M{operator: filter.Tags}
+	}
+
+	return query
+}
+
+func (m *mongoStore) CountTags(ctx context.Context, filter blogFilter) (map[string]int64, error) {
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: blogQuery(filter)}},
+		{{Key: "$unwind", Value: "$tags"}},
+		{{Key: "$group", Value: bson.M{"_id": "$tags", "count": bson.M{"$sum": 1}}}},
+	}
+	cursor, err := m.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	counts := make(map[string]int64)
+	for cursor.Next(ctx) {
+		var item struct {
+			Tag   string `bson:"_id"`
+			Count int64  `bson:"count"`
+		}
+		err := cursor.Decode(&item)
+		if err != nil {
+			return nil, err
+		}
+		counts[item.Tag] = item.Count
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+
+	return counts, nil
+}
+
+func (m *mongoStore) Usage(ctx context.Context) (blogUsage, error) {
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$group", Value: bson.M{
+			"_id":           nil,
+			"blogs":         bson.M{"$sum": 1},
+			"content_bytes": bson.M{"$sum": bson.M{"$strLenBytes": bson.M{"$ifNull": bson.A{"$content", ""}}}},
+		}}},
+	}
+	cursor, err := m.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return blogUsage{}, err
+	}
+	defer cursor.Close(ctx)
+
+	//without blogs the group has no result, so the usage is zero
+	var item struct {
+		Blogs        int64 `bson:"blogs"`
+		ContentBytes int64 `bson:"content_bytes"`
+	}
+	if cursor.Next(ctx) {
+		err := cursor.Decode(&item)
+		if err != nil {
+			return blogUsage{}, err
+		}
+	}
+	if err := cursor.Err(); err != nil {
+		return blogUsage{}, err
+	}
+
+	return blogUsage{Blogs: item.Blogs, ContentBytes: item.ContentBytes}, nil
+}
+
+func (m *mongoStore) RecordView(ctx context.Context, blogID string) (int64, error) {
+
+	//the views are in their own collection, so a view doesn't change the blog revision
+	count, err := m.collection.CountDocuments(ctx, bson.M{"_id": blogID, "deleted_at": nil}, options.Count().SetLimit(1))
+	if err != nil {
+		return 0, err
+	}
+	if count == 0 {
+		return 0, errBlogNotFound
+	}
+
+	var item viewsItem
+	opts := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)
+	err = m.views.FindOneAndUpdate(ctx, bson.M{"_id": blogID}, bson.M{"$inc": bson.M{"views": 1}}, opts).Decode(&item)
+	if err != nil {
+		return 0, err
+	}
+
+	return item.Views, nil
+}
+
+func (m *mongoStore) ViewCounts(ctx context.Context) (map[string]int64, error) {
+
+	cursor, err := m.views.Find(ctx, bson.M{})
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	counts := make(map[string]int64)
+	for cursor.Next(ctx) {
+		var item viewsItem
+		err := cursor.Decode(&item)
+		if err != nil {
+			return nil, err
+		}
+		counts[item.BlogID] = item.Views
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+
+	return counts, nil
+}
+
+func (m *mongoStore) CreateAuthor(ctx context.Context, author *blogpb.Author) (*blogpb.Author, error) {
+
+	_, err := m.authors.InsertOne(ctx, authorItemFromProto(author))
+	if err != nil {
+		if isDuplicateKeyError(err) {
+			return nil, errAuthorAlreadyExists
+		}
+		return nil, err
+	}
+
+	return author, nil
+}
+
+func (m *mongoStore) ReadAuthor(ctx context.Context, authorID string) (*blogpb.Author, error) {
+
+	data := &authorItem{}
+	err := m.authors.FindOne(ctx, bson.M{"_id": authorID}).Decode(data)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, errAuthorNotFound
+		}
+		return nil, err
+	}
+
+	return data.toProto(), nil
+}
+
+func (m *mongoStore) ListAuthors(ctx context.Context, afterID string, limit int, fn func(author *blogpb.Author) error) error {
+
+	query := bson.M{}
+	if afterID != "" {
+		query["_id"] = bson.M{"$gt": afterID}
+	}
+
+	return m.find(ctx, m.authors, query, limit, func(cursor *mongo.Cursor) error {
+		data := &authorItem{}
+		err := cursor.Decode(data)
+		if err != nil {
+			return err
+		}
+		return fn(data.toProto())
+	})
+}
+
+func (m *mongoStore) CreateComment(ctx context.Context, comment *blogpb.Comment) (*blogpb.Comment, error) {
+
+	_, err := m.ReadBlog(ctx, comment.GetBlogId())
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = m.comments.InsertOne(ctx, commentItemFromProto(comment))
+	if err != nil {
+		return nil, err
+	}
+
+	return comment, nil
+}
+
+func (m *mongoStore) ListComments(ctx context.Context, blogID, afterID string, limit int, fn func(comment *blogpb.Comment) error) error {
+
+	query := bson.M{"blog_id": blogID}
+	if afterID != "" {
+		query["_id"] = bson.M{"$gt": afterID}
+	}
+
+	return m.find(ctx, m.comments, query, limit, func(cursor *mongo.Cursor) error {
+		data := &commentItem{}
+		err := cursor.Decode(data)
+		if err != nil {
+			return err
+		}
+		return fn(data.toProto())
+	})
+}
+
+func (m *mongoStore) DeleteComment(ctx context.Context, commentID string) error {
+
+	res, err := m.comments.DeleteOne(ctx, bson.M{"_id": commentID})
+	if err != nil {
+		return err
+	}
+	if res.DeletedCount == 0 {
+		return errCommentNotFound
+	}
+
+	return nil
+}
+
+// find runs the query ordered by id and calls fn for each document
+func (m *mongoStore) find(ctx context.Context, collection *mongo.Collection, query bson.M, limit int, fn func(cursor *mongo.Cursor) error) error {
+
+	opts := options.Find().SetSort(bson.M{"_id": 1})
+	if limit > 0 {
+		opts.SetLimit(int64(limit))
+	}
+
+	cursor, err := collection.Find(ctx, query, opts)
+	if err != nil {
+		return err
+	}
+	defer cursor.Close(ctx)
+
+	for cursor.Next(ctx) {
+		err := fn(cursor)
+		if err != nil {
+			return err
+		}
+	}
+
+	return cursor.Err()
+}
+
+func (m *mongoStore) Close(ctx context.Context) error {
+	return m.client.Disconnect(ctx)
+}
+
+func isDuplicateKeyError(err error) bool {
+	var writeErr mongo.WriteException
+	if errors.As(err, &writeErr) {
+		for _, e := range writeErr.WriteErrors {
+			if e.Code == mongoDuplicateKeyCode {
+				return true
+			}
+		}
+	}
+	return false
+}
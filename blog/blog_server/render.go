@@ -0,0 +1,120 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/diegoclair/grpc-go-course/blog/blogpb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	renderCacheSize = 1000 //max number of rendered blogs kept in memory
+	excerptSize     = 200  //approximate number of bytes of the excerpt
+)
+
+// renderCache keeps the last rendered revision of each blog, a new revision replaces the old one
+type renderCache struct {
+	mu      sync.Mutex
+	entries map[string]*blogpb.RenderBlogResponse //blog id -> rendered blog
+}
+
+func newRenderCache() *renderCache {
+	return &renderCache{
+		entries: make(map[string]*blogpb.RenderBlogResponse),
+	}
+}
+
+func (c *renderCache) get(blogID string, revision int64) (*blogpb.RenderBlogResponse, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[blogID]
+	if !ok || entry.GetRevision() != revision {
+		return nil, false
+	}
+	return proto.Clone(entry).(*blogpb.RenderBlogResponse), true
+}
+
+func (c *renderCache) put(entry *blogpb.RenderBlogResponse) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	//when it is full we remove any other blog, the map iteration order is random
+	if _, ok := c.entries[entry.GetBlogId()]; !ok && len(c.entries) >= renderCacheSize {
+		for blogID := range c.entries {
+			delete(c.entries, blogID)
+			break
+		}
+	}
+	c.entries[entry.GetBlogId()] = proto.Clone(entry).(*blogpb.RenderBlogResponse)
+}
+
+func (c *renderCache) remove(blogID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.entries, blogID)
+}
+
+func (s *server) RenderBlog(ctx context.Context, req *blogpb.RenderBlogRequest) (*blogpb.RenderBlogResponse, error) {
+	fmt.Printf("RenderBlog function was invoked with %v\n", req)
+	t := tenantFromContext(ctx)
+
+	blogID := req.GetBlogId()
+	if blogID == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "The blog_id is required")
+	}
+
+	//we always read the blog, so the cache is only used if it has the current revision
+	blog, err := t.store.ReadBlog(ctx, blogID)
+	if err != nil {
+		return nil, storeError(err, blogID)
+	}
+	if cached, ok := t.renders.get(blogID, blog.GetRevision()); ok {
+		return cached, nil
+	}
+
+	rendered := renderMarkdown(blog.GetContent())
+	res := &blogpb.RenderBlogResponse{
+		BlogId:   blogID,
+		Revision: blog.GetRevision(),
+		Html:     rendered.html,
+		Toc:      rendered.toc,
+		Excerpt:  excerpt(rendered.text),
+	}
+	t.renders.put(res)
+
+	return res, nil
+}
+
+// excerpt returns the start of the text, cut between words
+func excerpt(text string) string {
+	if len(text) <= excerptSize {
+		return text
+	}
+
+	end := excerptSize
+	for end > 0 && !isWordBoundary(text, end) {
+		end--
+	}
+	if end == 0 {
+		end = excerptSize //a huge word, we cut it in the first rune boundary
+		for end < len(text) && !isRuneStart(text[end]) {
+			end++
+		}
+	}
+
+	return strings.TrimRightFunc(text[:end], func(r rune) bool {
+		return unicode.IsSpace(r) || unicode.IsPunct(r)
+	}) + "..."
+}
+
+func isRuneStart(b byte) bool {
+	return b&0xC0 != 0x80
+}
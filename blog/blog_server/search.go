@@ -0,0 +1,405 @@
+package main
+
+import (
+	"context"
+	"html"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/diegoclair/grpc-go-course/blog/blogpb"
+	"google.golang.org/protobuf/proto"
+)
+
+// The search index is an inverted index: for each term we keep the blogs that have it.
+// The score is a BM25 (https://en.wikipedia.org/wiki/Okapi_BM25) of the title and the content,
+// the matches in the title count more than the ones in the content.
+// Only the published blogs are indexed, the drafts, the blogs in review and the archived ones are not searched.
+
+const (
+	bm25K1          = 1.2
+	bm25B           = 0.75
+	titleBoost      = 2.0
+	snippetSize     = 160 //approximate number of bytes of the snippet
+	highlightPrefix = "<em>"
+	highlightSuffix = "</em>"
+)
+
+type token struct {
+	term       string
+	start, end int //byte offsets in the original text
+}
+
+type indexedBlog struct {
+	blog    *blogpb.Blog
+	title   []token
+	content []token
+}
+
+type searchIndex struct {
+	mu       sync.RWMutex
+	blogs    map[string]*indexedBlog
+	postings map[string]map[string]struct{} //term -> ids of the blogs that have it
+
+	totalTitleLen   int //used to get the average length of the fields
+	totalContentLen int
+}
+
+// searchQuery is the parsed query, each phrase has one or more terms
+type searchQuery struct {
+	phrases [][]string
+}
+
+type searchHit struct {
+	blog  *indexedBlog
+	score float64
+}
+
+func newSearchIndex() *searchIndex {
+	return &searchIndex{
+		blogs:    make(map[string]*indexedBlog),
+		postings: make(map[string]map[string]struct{}),
+	}
+}
+
+// load indexes all the published blogs of the store, it is used when the server starts
+func (idx *searchIndex) load(ctx context.Context, store BlogStore) error {
+	return store.ListBlogs(ctx, blogFilter{Status: blogpb.BlogStatus_BLOG_STATUS_PUBLISHED}, func(blog *blogpb.Blog) error {
+		idx.add(blog)
+		return nil
+	})
+}
+
+// add indexes the blog, replacing the previous version of it
+func (idx *searchIndex) add(blog *blogpb.Blog) {
+	doc := &indexedBlog{
+		blog:    proto.Clone(blog).(*blogpb.Blog),
+		title:   tokenize(blog.GetTitle()),
+		content: tokenize(blog.GetContent()),
+	}
+
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	idx.removeLocked(blog.GetId())
+
+	idx.blogs[blog.GetId()] = doc
+	idx.totalTitleLen += len(doc.title)
+	idx.totalContentLen += len(doc.content)
+	for _, tokens := range [][]token{doc.title, doc.content} {
+		for _, t := range tokens {
+			ids, ok := idx.postings[t.term]
+			if !ok {
+				ids = make(map[string]struct{})
+				idx.postings[t.term] = ids
+			}
+			ids[blog.GetId()] = struct{}{}
+		}
+	}
+}
+
+func (idx *searchIndex) remove(blogID string) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	idx.removeLocked(blogID)
+}
+
+// removeLocked removes the blog from the index, idx.mu must be held
+func (idx *searchIndex) removeLocked(blogID string) {
+	doc, ok := idx.blogs[blogID]
+	if !ok {
+		return
+	}
+
+	for _, tokens := range [][]token{doc.title, doc.content} {
+		for _, t := range tokens {
+			ids := idx.postings[t.term]
+			delete(ids, blogID)
+			if len(ids) == 0 {
+				delete(idx.postings, t.term)
+			}
+		}
+	}
+	idx.totalTitleLen -= len(doc.title)
+	idx.totalContentLen -= len(doc.content)
+	delete(idx.blogs, blogID)
+}
+
+// search returns the blogs that have all the phrases of the query, the best ones first
+func (idx *searchIndex) search(query searchQuery, authorID string, limit int) []*blogpb.SearchResult {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	terms := query.terms()
+	if len(terms) == 0 || len(idx.blogs) == 0 {
+		return nil
+	}
+
+	hits := make([]searchHit, 0)
+	for blogID := range idx.candidates(terms) {
+		doc := idx.blogs[blogID]
+		if authorID != "" && doc.blog.GetAuthorId() != authorID {
+			continue
+		}
+		if !doc.hasPhrases(query.phrases) {
+			continue
+		}
+		hits = append(hits, searchHit{
+			blog:  doc,
+			score: idx.score(doc, terms),
+		})
+	}
+
+	sort.Slice(hits, func(i, j int) bool {
+		if hits[i].score != hits[j].score {
+			return hits[i].score > hits[j].score
+		}
+		return hits[i].blog.blog.GetId() < hits[j].blog.blog.GetId()
+	})
+	if len(hits) > limit {
+		hits = hits[:limit]
+	}
+
+	results := make([]*blogpb.SearchResult, 0, len(hits))
+	for _, hit := range hits {
+		results = append(results, &blogpb.SearchResult{
+			Blog:           proto.Clone(hit.blog.blog).(*blogpb.Blog),
+			Score:          hit.score,
+			TitleHighlight: highlight(hit.blog.blog.GetTitle(), hit.blog.title, terms, 0, len(hit.blog.blog.GetTitle())),
+			Snippet:        snippet(hit.blog.blog.GetContent(), hit.blog.content, terms),
+		})
+	}
+
+	return results
+}
+
+// candidates returns the ids of the blogs that have all the terms, idx.mu must be held
+func (idx *searchIndex) candidates(terms []string) map[string]struct{} {
+
+	//we start from the rarest term, so we check the smallest number of blogs
+	sorted := append([]string(nil), terms...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return len(idx.postings[sorted[i]]) < len(idx.postings[sorted[j]])
+	})
+
+	result := make(map[string]struct{})
+	for blogID := range idx.postings[sorted[0]] {
+		result[blogID] = struct{}{}
+	}
+	for _, term := range sorted[1:] {
+		ids := idx.postings[term]
+		for blogID := range result {
+			if _, ok := ids[blogID]; !ok {
+				delete(result, blogID)
+			}
+		}
+	}
+
+	return result
+}
+
+// score returns the BM25 of the blog for the terms, idx.mu must be held
+func (idx *searchIndex) score(doc *indexedBlog, terms []string) float64 {
+
+	n := float64(len(idx.blogs))
+	avgTitle := math.Max(float64(idx.totalTitleLen)/n, 1)
+	avgContent := math.Max(float64(idx.totalContentLen)/n, 1)
+
+	var score float64
+	for _, term := range terms {
+		df := float64(len(idx.postings[term]))
+		idf := math.Log(1 + (n-df+0.5)/(df+0.5))
+
+		score += idf * titleBoost * bm25(termFrequency(doc.title, term), float64(len(doc.title)), avgTitle)
+		score += idf * bm25(termFrequency(doc.content, term), float64(len(doc.content)), avgContent)
+	}
+
+	return score
+}
+
+func bm25(tf, length, avgLength float64) float64 {
+	if tf == 0 {
+		return 0
+	}
+	return tf * (bm25K1 + 1) / (tf + bm25K1*(1-bm25B+bm25B*length/avgLength))
+}
+
+func termFrequency(tokens []token, term string) float64 {
+	var tf float64
+	for _, t := range tokens {
+		if t.term == term {
+			tf++
+		}
+	}
+	return tf
+}
+
+// hasPhrases checks if each phrase is in the title or in the content
+func (doc *indexedBlog) hasPhrases(phrases [][]string) bool {
+	for _, phrase := range phrases {
+		if len(phrase) > 1 && !hasPhrase(doc.title, phrase) && !hasPhrase(doc.content, phrase) {
+			return false
+		}
+	}
+	return true
+}
+
+func hasPhrase(tokens []token, phrase []string) bool {
+	for i := 0; i+len(phrase) <= len(tokens); i++ {
+		found := true
+		for j, term := range phrase {
+			if tokens[i+j].term != term {
+				found = false
+				break
+			}
+		}
+		if found {
+			return true
+		}
+	}
+	return false
+}
+
+// parseSearchQuery splits the query in phrases, the words inside double quotes are one phrase
+func parseSearchQuery(query string) searchQuery {
+
+	var q searchQuery
+	parts := strings.Split(query, `"`)
+	for i, part := range parts {
+		terms := make([]string, 0)
+		for _, t := range tokenize(part) {
+			terms = append(terms, t.term)
+		}
+		if len(terms) == 0 {
+			continue
+		}
+
+		//the odd parts are inside quotes
+		if i%2 == 1 {
+			q.phrases = append(q.phrases, terms)
+			continue
+		}
+		for _, term := range terms {
+			q.phrases = append(q.phrases, []string{term})
+		}
+	}
+
+	return q
+}
+
+// terms returns the distinct terms of all the phrases
+func (q searchQuery) terms() []string {
+	seen := make(map[string]struct{})
+	terms := make([]string, 0)
+	for _, phrase := range q.phrases {
+		for _, term := range phrase {
+			if _, ok := seen[term]; ok {
+				continue
+			}
+			seen[term] = struct{}{}
+			terms = append(terms, term)
+		}
+	}
+	return terms
+}
+
+// tokenize splits the text in lower case words, keeping their position in the text
+func tokenize(text string) []token {
+	tokens := make([]token, 0)
+	start := -1
+	for i, r := range text {
+		isWord := unicode.IsLetter(r) || unicode.IsDigit(r)
+		if isWord && start < 0 {
+			start = i
+		}
+		if !isWord && start >= 0 {
+			tokens = append(tokens, token{term: strings.ToLower(text[start:i]), start: start, end: i})
+			start = -1
+		}
+	}
+	if start >= 0 {
+		tokens = append(tokens, token{term: strings.ToLower(text[start:]), start: start, end: len(text)})
+	}
+	return tokens
+}
+
+// highlight returns text[from:to] with the tokens of the terms inside the highlight tags.
+// The result is html, so the text is escaped, otherwise a title with tags would be rendered by the clients.
+func highlight(text string, tokens []token, terms []string, from, to int) string {
+
+	var b strings.Builder
+	last := from
+	for _, t := range tokens {
+		if t.start < from || t.end > to || !containsTerm(terms, t.term) {
+			continue
+		}
+		b.WriteString(html.EscapeString(text[last:t.start]))
+		b.WriteString(highlightPrefix)
+		b.WriteString(html.EscapeString(text[t.start:t.end]))
+		b.WriteString(highlightSuffix)
+		last = t.end
+	}
+	b.WriteString(html.EscapeString(text[last:to]))
+
+	return b.String()
+}
+
+// snippet returns the part of the content around the first matched term
+func snippet(content string, tokens []token, terms []string) string {
+
+	first := -1
+	for _, t := range tokens {
+		if containsTerm(terms, t.term) {
+			first = t.start
+			break
+		}
+	}
+	if first < 0 {
+		first = 0
+	}
+
+	from := first - snippetSize/4
+	if from < 0 {
+		from = 0
+	}
+	to := from + snippetSize
+	if to > len(content) {
+		to = len(content)
+	}
+
+	//we only cut the content between words, so we don't break a word or a multi byte character
+	for from > 0 && !isWordBoundary(content, from) {
+		from--
+	}
+	for to < len(content) && !isWordBoundary(content, to) {
+		to++
+	}
+
+	result := highlight(content, tokens, terms, from, to)
+	if from > 0 {
+		result = "..." + strings.TrimLeftFunc(result, unicode.IsSpace)
+	}
+	if to < len(content) {
+		result = strings.TrimRightFunc(result, unicode.IsSpace) + "..."
+	}
+
+	return result
+}
+
+func isWordBoundary(text string, i int) bool {
+	r, _ := utf8.DecodeRuneInString(text[i:])
+	return r != utf8.RuneError && !unicode.IsLetter(r) && !unicode.IsDigit(r)
+}
+
+func containsTerm(terms []string, term string) bool {
+	for _, t := range terms {
+		if t == term {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,236 @@
+package main
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/diegoclair/grpc-go-course/blog/blogpb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func newTestSearchIndex(blogs ...*blogpb.Blog) *searchIndex {
+	idx := newSearchIndex()
+	for _, blog := range blogs {
+		idx.add(blog)
+	}
+	return idx
+}
+
+// resultIDs returns the ids of the blogs found, in the order of the results
+func resultIDs(results []*blogpb.SearchResult) []string {
+	ids := make([]string, 0, len(results))
+	for _, result := range results {
+		ids = append(ids, result.GetBlog().GetId())
+	}
+	return ids
+}
+
+func TestSearchRanking(t *testing.T) {
+	idx := newTestSearchIndex(
+		&blogpb.Blog{Id: "in the title", Title: "go tips", Content: "some words here ok"},
+		&blogpb.Blog{Id: "in the content", Title: "some tips", Content: "go words here ok"},
+		&blogpb.Blog{Id: "twice in the content", Title: "some tips", Content: "go go here ok"},
+		&blogpb.Blog{Id: "in a long content", Title: "some tips", Content: "go words here ok and many more words after it"},
+		&blogpb.Blog{Id: "without the term", Title: "some tips", Content: "rust words here ok"},
+	)
+
+	//the title counts more, then the frequency of the term, then the shortest content
+	want := []string{"in the title", "twice in the content", "in the content", "in a long content"}
+	got := resultIDs(idx.search(parseSearchQuery("Go"), "", 10))
+	if len(got) != len(want) {
+		t.Fatalf("search returned %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("search returned %v, want %v", got, want)
+		}
+	}
+
+	if got := resultIDs(idx.search(parseSearchQuery("go"), "", 2)); len(got) != 2 || got[0] != "in the title" {
+		t.Errorf("the limit should keep the best results, got %v", got)
+	}
+
+	//all the terms must be in the blog
+	if got := resultIDs(idx.search(parseSearchQuery("go rust"), "", 10)); len(got) != 0 {
+		t.Errorf("no blog has both terms, got %v", got)
+	}
+
+	idx.remove("in the title")
+	if got := resultIDs(idx.search(parseSearchQuery("go"), "", 10)); len(got) != 3 || got[0] != "twice in the content" {
+		t.Errorf("the removed blog should not be found, got %v", got)
+	}
+}
+
+func TestSearchPhrases(t *testing.T) {
+	idx := newTestSearchIndex(
+		&blogpb.Blog{Id: "phrase", AuthorId: "diego", Title: "Animals", Content: "The quick brown fox."},
+		&blogpb.Blog{Id: "swapped", AuthorId: "diego", Title: "Animals", Content: "The brown, quick fox."},
+		&blogpb.Blog{Id: "in the title", AuthorId: "other", Title: "Quick brown dogs", Content: "Dogs."},
+	)
+
+	tests := []struct {
+		query    string
+		authorID string
+		want     int
+	}{
+		{`quick brown`, "", 3},
+		{`"quick brown"`, "", 2},
+		{`"QUICK BROWN" fox`, "", 1},
+		{`"brown quick"`, "", 1},
+		{`"quick brown"`, "diego", 1},
+		{`"fox quick"`, "", 0},
+	}
+	for _, test := range tests {
+		got := resultIDs(idx.search(parseSearchQuery(test.query), test.authorID, 10))
+		if len(got) != test.want {
+			t.Errorf("search(%q) of the author %q returned %v, want %v blogs", test.query, test.authorID, got, test.want)
+		}
+	}
+}
+
+func TestSearchHighlights(t *testing.T) {
+	idx := newTestSearchIndex(&blogpb.Blog{Id: "a", Title: "Go and Gophers", Content: "Learn go. Go is fun."})
+
+	results := idx.search(parseSearchQuery("go"), "", 10)
+	if len(results) != 1 {
+		t.Fatalf("search returned %v", results)
+	}
+	if got, want := results[0].GetTitleHighlight(), "<em>Go</em> and Gophers"; got != want {
+		t.Errorf("the title highlight is %q, want %q", got, want)
+	}
+	if got, want := results[0].GetSnippet(), "Learn <em>go</em>. <em>Go</em> is fun."; got != want {
+		t.Errorf("the snippet is %q, want %q", got, want)
+	}
+
+	//the tags of the blog are text, only the highlight tags are html
+	idx = newTestSearchIndex(&blogpb.Blog{Id: "b", Title: "<script>go</script>", Content: `Go & "Rust" <b>`})
+	results = idx.search(parseSearchQuery("go"), "", 10)
+	if len(results) != 1 {
+		t.Fatalf("search returned %v", results)
+	}
+	if got, want := results[0].GetTitleHighlight(), "&lt;script&gt;<em>go</em>&lt;/script&gt;"; got != want {
+		t.Errorf("the title highlight is %q, want %q", got, want)
+	}
+	if got, want := results[0].GetSnippet(), "<em>Go</em> &amp; &#34;Rust&#34; &lt;b&gt;"; got != want {
+		t.Errorf("the snippet is %q, want %q", got, want)
+	}
+}
+
+// searchIDs returns the ids of the blogs found by SearchBlogs
+func searchIDs(t *testing.T, s *server, ctx context.Context, query string) []string {
+	t.Helper()
+
+	res, err := s.SearchBlogs(ctx, &blogpb.SearchBlogsRequest{Query: query})
+	if err != nil {
+		t.Fatalf("SearchBlogs: %v", err)
+	}
+	return resultIDs(res.GetResults())
+}
+
+// publishTestBlog moves the draft to review and publishes it
+func publishTestBlog(t *testing.T, s *server, ctx context.Context, blogID string) {
+	t.Helper()
+
+	_, err := s.SubmitBlogForReview(ctx, &blogpb.SubmitBlogForReviewRequest{BlogId: blogID})
+	if err != nil {
+		t.Fatalf("SubmitBlogForReview: %v", err)
+	}
+	_, err = s.PublishBlog(ctx, &blogpb.PublishBlogRequest{BlogId: blogID})
+	if err != nil {
+		t.Fatalf("PublishBlog: %v", err)
+	}
+}
+
+func TestSearchBlogsIndexesTheChanges(t *testing.T) {
+	s, ctx := newTestServer(t)
+	authorID := createTestAuthor(t, s, ctx)
+	blog := createTestBlog(t, s, ctx, authorID, "Searchable title")
+
+	if ids := searchIDs(t, s, ctx, "searchable"); len(ids) != 0 {
+		t.Errorf("the draft should not be found, got %v", ids)
+	}
+	publishTestBlog(t, s, ctx, blog.GetId())
+	if ids := searchIDs(t, s, ctx, "searchable"); len(ids) != 1 {
+		t.Fatalf("the published blog should be found, got %v", ids)
+	}
+
+	_, err := s.ArchiveBlog(ctx, &blogpb.ArchiveBlogRequest{BlogId: blog.GetId()})
+	if err != nil {
+		t.Fatalf("ArchiveBlog: %v", err)
+	}
+	if ids := searchIDs(t, s, ctx, "searchable"); len(ids) != 0 {
+		t.Errorf("the archived blog should not be found, got %v", ids)
+	}
+	_, err = s.ReturnBlogToDraft(ctx, &blogpb.ReturnBlogToDraftRequest{BlogId: blog.GetId()})
+	if err != nil {
+		t.Fatalf("ReturnBlogToDraft: %v", err)
+	}
+	publishTestBlog(t, s, ctx, blog.GetId())
+
+	_, err = s.DeleteBlog(ctx, &blogpb.DeleteBlogRequest{BlogId: blog.GetId()})
+	if err != nil {
+		t.Fatalf("DeleteBlog: %v", err)
+	}
+	if ids := searchIDs(t, s, ctx, "searchable"); len(ids) != 0 {
+		t.Errorf("the deleted blog should not be found, got %v", ids)
+	}
+
+	_, err = s.SearchBlogs(ctx, &blogpb.SearchBlogsRequest{Query: `" "`})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("SearchBlogs without words returned %v, want INVALID_ARGUMENT", err)
+	}
+}
+
+func TestSearchIndexLoadsThePublishedBlogs(t *testing.T) {
+	ctx := context.Background()
+	store := newMemoryStore()
+	blogs := []*blogpb.Blog{
+		{Id: "published", Title: "go", Status: blogpb.BlogStatus_BLOG_STATUS_PUBLISHED},
+		{Id: "before the workflow", Title: "go"},
+		{Id: "draft", Title: "go", Status: blogpb.BlogStatus_BLOG_STATUS_DRAFT},
+		{Id: "in review", Title: "go", Status: blogpb.BlogStatus_BLOG_STATUS_IN_REVIEW},
+		{Id: "archived", Title: "go", Status: blogpb.BlogStatus_BLOG_STATUS_ARCHIVED},
+	}
+	for _, blog := range blogs {
+		_, err := store.CreateBlog(ctx, blog)
+		if err != nil {
+			t.Fatalf("CreateBlog: %v", err)
+		}
+	}
+
+	idx := newSearchIndex()
+	err := idx.load(ctx, store)
+	if err != nil {
+		t.Fatalf("load: %v", err)
+	}
+	ids := resultIDs(idx.search(parseSearchQuery("go"), "", 10))
+	sort.Strings(ids)
+	if strings.Join(ids, ",") != "before the workflow,published" {
+		t.Errorf("the index should have only the published blogs, got %v", ids)
+	}
+}
+
+func TestImportedBlogsInTheTrash(t *testing.T) {
+	s, ctx := newTestServer(t)
+	authorID := createTestAuthor(t, s, ctx)
+	tn := tenantFromContext(ctx)
+
+	trashed := &blogpb.Blog{Id: "trashed", AuthorId: authorID, Title: "Imported title", Status: blogpb.BlogStatus_BLOG_STATUS_PUBLISHED, DeletedAt: timestamppb.Now()}
+	_, err := tn.createBlog(ctx, trashed, true)
+	if err != nil {
+		t.Fatalf("createBlog: %v", err)
+	}
+	if results := tn.index.search(parseSearchQuery("imported"), "", 10); len(results) != 0 {
+		t.Errorf("the imported blog in the trash should not be indexed, got %v", results)
+	}
+
+	//only the imports keep the deleted_at, a new blog is never in the trash
+	res, err := s.CreateBlog(ctx, &blogpb.CreateBlogRequest{Blog: &blogpb.Blog{AuthorId: authorID, Title: "New title", DeletedAt: timestamppb.Now()}})
+	if err != nil || res.GetBlog().GetDeletedAt() != nil {
+		t.Errorf("CreateBlog should ignore the deleted_at, got %v (%v)", res, err)
+	}
+}
@@ -1,39 +1,728 @@
 package main
 
 import (
+	"context"
+	"errors"
+	"flag"
 	"fmt"
+	"io"
 	"log"
 	"net"
 	"os"
 	"os/signal"
+	"strings"
+	"time"
 
 	"github.com/diegoclair/grpc-go-course/blog/blogpb"
+	"google.golang.org/genproto/googleapis/rpc/code"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 const (
-	port = ":50051"
+	defaultPageSize = 50
+	maxPageSize     = 1000
+
+	defaultSearchLimit = 20
+	maxSearchLimit     = 100
+)
+
+var (
+	configFile = flag.String("config", "", "json or yaml file with the settings, the keys are the flag names, like storage: mongo")
+
+	listenAddress   = flag.String("listen-address", ":50051", "host and port where the server listens, an empty host listens on all the interfaces")
+	tlsCertFile     = flag.String("tls-cert-file", "", "certificate of the server, like ssl/server.crt, without it the server doesn't use tls")
+	tlsKeyFile      = flag.String("tls-key-file", "", "private key of the -tls-cert-file, like ssl/server.pem")
+	connectTimeout  = flag.Duration("connect-timeout", 10*time.Second, "how long the server waits for the storage of each tenant when it starts")
+	shutdownTimeout = flag.Duration("shutdown-timeout", 10*time.Second, "how long the server waits for the storage to close when it stops")
+
+	storage       = flag.String("storage", "memory", "where the blogs are saved: memory, file or mongo")
+	mongoURI      = flag.String("mongo-uri", "mongodb://localhost:27017", "mongo connection uri, used when -storage=mongo")
+	mongoUsername = flag.String("mongo-username", "root", "mongo user, used when the -mongo-uri has no credentials")
+	mongoPassword = flag.String("mongo-password", "root", "password of the -mongo-username")
+	mongoDatabase = flag.String("mongo-database", "blog", "mongo database name, used when -storage=mongo")
+
+	migrateOnStart = flag.Bool("migrate", true, "applies the pending migrations of the storage when the server starts, without it they are only reported")
+
+	filePath        = flag.String("file-path", "blog/filedata/blogs.log", "path of the blog log file, used when -storage=file")
+	compactInterval = flag.Duration("compact-interval", 5*time.Minute, "how often the blog log file is compacted, used when -storage=file")
+
+	trashRetention = flag.Duration("trash-retention", 30*24*time.Hour, "how long the deleted blogs are kept in the trash before they are purged")
+	purgeInterval  = flag.Duration("purge-interval", time.Hour, "how often the expired blogs are purged from the trash")
+
+	attachmentsDir    = flag.String("attachments-dir", "blog/filedata/attachments", "directory where the attachments are saved")
+	maxAttachmentSize = flag.Int64("max-attachment-size", 10<<20, "max size of an attachment, in bytes")
+
+	tenantsFile     = flag.String("tenants-file", "", "json file with the tenants and their api keys, without it there is only the default tenant")
+	maxBlogs        = flag.Int64("max-blogs", 0, "max number of blogs of each tenant, including the trash, 0 is unlimited")
+	maxContentBytes = flag.Int64("max-content-bytes", 0, "max size of the contents of all the blogs of each tenant, 0 is unlimited")
+
+	cacheSize = flag.Int64("cache-size", 32<<20, "max bytes of the blogs and list pages kept in memory by each tenant, 0 disables the cache")
+
+	idempotencyRetention = flag.Duration("idempotency-retention", 24*time.Hour, "how long the results of the requests with an idempotency key are kept for the retries")
 )
 
 type server struct {
 	blogpb.UnimplementedBlogServiceServer
+
+	tenants        map[string]*tenant //id -> tenant
+	apiKeys        map[string]*tenant //api key -> tenant, empty when there is only the default tenant
+	trashRetention time.Duration
+	scheduleWake   chan struct{} //signals the scheduler that a blog was scheduled
+}
+
+func newServer(tenants []*tenant, trashRetention time.Duration) *server {
+	s := &server{
+		tenants:        make(map[string]*tenant),
+		apiKeys:        make(map[string]*tenant),
+		trashRetention: trashRetention,
+		scheduleWake:   make(chan struct{}, 1),
+	}
+	for _, t := range tenants {
+		s.tenants[t.id] = t
+		for _, key := range t.apiKeys {
+			s.apiKeys[key] = t
+		}
+	}
+	return s
+}
+
+// blogChanged must be called after each change in the store, to keep the index, the caches and the watchers updated
+func (t *tenant) blogChanged(eventType blogpb.BlogEventType, blog *blogpb.Blog) {
+	switch {
+	case eventType == blogpb.BlogEventType_BLOG_EVENT_TYPE_DELETED:
+		t.index.remove(blog.GetId())
+		t.renders.remove(blog.GetId())
+	case blogStatus(blog) != blogpb.BlogStatus_BLOG_STATUS_PUBLISHED:
+		//only the published blogs are searched, so a blog that goes back to draft or is archived leaves the index
+		t.index.remove(blog.GetId())
+	default:
+		t.index.add(blog)
+	}
+	t.watcher.publish(eventType, blog)
+}
+
+func (s *server) CreateBlog(ctx context.Context, req *blogpb.CreateBlogRequest) (*blogpb.CreateBlogResponse, error) {
+	fmt.Printf("CreateBlog function was invoked with %v\n", req)
+	t := tenantFromContext(ctx)
+
+	created, err := t.createBlog(ctx, req.GetBlog(), false)
+	if err != nil {
+		return nil, err
+	}
+
+	return &blogpb.CreateBlogResponse{
+		Blog: created,
+	}, nil
+}
+
+// createBlog validates and saves a new blog.
+// When imported is true, the revision and timestamps of the blog are kept if they are filled.
+func (t *tenant) createBlog(ctx context.Context, blog *blogpb.Blog, imported bool) (*blogpb.Blog, error) {
+
+	data, err := t.newBlog(ctx, blog, imported)
+	if err != nil {
+		return nil, err
+	}
+
+	t.quotaMu.Lock()
+	defer t.quotaMu.Unlock()
+	err = t.checkQuota(ctx, 1, int64(len(data.GetContent())))
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := t.store.CreateBlog(ctx, data)
+	if err != nil {
+		return nil, storeError(err, data.GetId())
+	}
+	//an imported blog can already be in the trash, then it is not searched nor watched, like the trashed blogs
+	if created.GetDeletedAt() == nil {
+		t.blogChanged(blogpb.BlogEventType_BLOG_EVENT_TYPE_CREATED, created)
+	}
+
+	return created, nil
+}
+
+// newBlog validates the blog and returns it as it will be saved, with the id, status, revision and timestamps
+func (t *tenant) newBlog(ctx context.Context, blog *blogpb.Blog, imported bool) (*blogpb.Blog, error) {
+
+	if blog == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "The blog is required")
+	}
+	if strings.TrimSpace(blog.GetAuthorId()) == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "The blog author_id is required")
+	}
+	if strings.TrimSpace(blog.GetTitle()) == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "The blog title is required")
+	}
+	err := t.checkAuthor(ctx, blog.GetAuthorId())
+	if err != nil {
+		return nil, err
+	}
+
+	data := proto.Clone(blog).(*blogpb.Blog)
+	err = normalizeFacets(data)
+	if err != nil {
+		return nil, err
+	}
+	setInitialStatus(data, imported)
+	if data.GetId() == "" {
+		id, err := newID()
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not generate the blog id: %v", err)
+		}
+		data.Id = id
+	}
+
+	now := timestamppb.Now()
+	if !imported || data.GetRevision() <= 0 {
+		data.Revision = 1
+	}
+	if !imported || data.GetCreatedAt() == nil {
+		data.CreatedAt = now
+	}
+	if !imported || data.GetUpdatedAt() == nil {
+		data.UpdatedAt = data.GetCreatedAt()
+	}
+	if !imported {
+		data.DeletedAt = nil
+	}
+
+	return data, nil
+}
+
+func (s *server) ReadBlog(ctx context.Context, req *blogpb.ReadBlogRequest) (*blogpb.ReadBlogResponse, error) {
+	fmt.Printf("ReadBlog function was invoked with %v\n", req)
+	t := tenantFromContext(ctx)
+
+	blogID := req.GetBlogId()
+	if blogID == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "The blog_id is required")
+	}
+	mask, err := parseBlogMask(req.GetReadMask(), "read_mask")
+	if err != nil {
+		return nil, err
+	}
+
+	blog, err := t.store.ReadBlog(ctx, blogID)
+	if err != nil {
+		return nil, storeError(err, blogID)
+	}
+
+	return &blogpb.ReadBlogResponse{
+		Blog: mask.read(blog),
+	}, nil
+}
+
+func (s *server) UpdateBlog(ctx context.Context, req *blogpb.UpdateBlogRequest) (*blogpb.UpdateBlogResponse, error) {
+	fmt.Printf("UpdateBlog function was invoked with %v\n", req)
+	t := tenantFromContext(ctx)
+
+	blog := req.GetBlog()
+	mask, err := parseUpdateRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	//if the blog changes after this read, the store returns a revision conflict
+	current, err := t.store.ReadBlog(ctx, blog.GetId())
+	if err != nil {
+		return nil, storeError(err, blog.GetId())
+	}
+	data, err := t.updatedBlog(ctx, current, blog, mask)
+	if err != nil {
+		return nil, err
+	}
+
+	//the current blog may have changed after we read it, but then the update fails with a revision conflict
+	t.quotaMu.Lock()
+	defer t.quotaMu.Unlock()
+	err = t.checkQuota(ctx, 0, int64(len(data.GetContent())-len(current.GetContent())))
+	if err != nil {
+		return nil, err
+	}
+
+	data, err = t.store.UpdateBlog(ctx, data, req.GetExpectedRevision())
+	if err != nil {
+		return nil, storeError(err, blog.GetId())
+	}
+	t.blogChanged(blogpb.BlogEventType_BLOG_EVENT_TYPE_UPDATED, data)
+
+	return &blogpb.UpdateBlogResponse{
+		Blog: data,
+	}, nil
+}
+
+// parseUpdateRequest validates the fields of the request that don't depend on the stored blog
+func parseUpdateRequest(req *blogpb.UpdateBlogRequest) (blogMask, error) {
+
+	if req.GetBlog().GetId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "The blog id is required")
+	}
+	if req.GetExpectedRevision() <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "The expected_revision is required")
+	}
+
+	return parseUpdateMask(req.GetUpdateMask())
+}
+
+// updatedBlog validates the changes of the blog and returns it as it will replace the current one
+func (t *tenant) updatedBlog(ctx context.Context, current, blog *blogpb.Blog, mask blogMask) (*blogpb.Blog, error) {
+
+	//without a mask the client sends the whole blog, with it only the masked fields are changed
+	data := proto.Clone(blog).(*blogpb.Blog)
+	if mask != nil {
+		data = proto.Clone(current).(*blogpb.Blog)
+		mask.merge(data, blog)
+	}
+
+	if strings.TrimSpace(data.GetAuthorId()) == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "The blog author_id is required")
+	}
+	if strings.TrimSpace(data.GetTitle()) == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "The blog title is required")
+	}
+	err := t.checkAuthor(ctx, data.GetAuthorId())
+	if err != nil {
+		return nil, err
+	}
+
+	err = normalizeFacets(data)
+	if err != nil {
+		return nil, err
+	}
+
+	//the status is only changed by the workflow rpcs, so we keep the stored one
+	data.Status = current.GetStatus()
+	data.PublishAt = current.GetPublishAt()
+	data.PublishedAt = current.GetPublishedAt()
+	data.UpdatedAt = timestamppb.Now()
+
+	return data, nil
+}
+
+func (s *server) DeleteBlog(ctx context.Context, req *blogpb.DeleteBlogRequest) (*blogpb.DeleteBlogResponse, error) {
+	fmt.Printf("DeleteBlog function was invoked with %v\n", req)
+	t := tenantFromContext(ctx)
+
+	blogID := req.GetBlogId()
+	if blogID == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "The blog_id is required")
+	}
+
+	//by default the blog goes to the trash, the cascade only matters when it is removed
+	var deleted *blogpb.Blog
+	var err error
+	if req.GetPermanent() {
+		deleted, err = t.store.DeleteBlog(ctx, blogID, req.GetCascade())
+		if err == nil {
+			t.deleteAttachments(blogID)
+		}
+	} else {
+		deleted, err = t.store.TrashBlog(ctx, blogID, time.Now())
+	}
+	if err != nil {
+		return nil, storeError(err, blogID)
+	}
+	//a blog removed from the trash was already sent to the watchers as deleted when it was trashed
+	if !req.GetPermanent() || deleted.GetDeletedAt() == nil {
+		t.blogChanged(blogpb.BlogEventType_BLOG_EVENT_TYPE_DELETED, deleted)
+	}
+
+	return &blogpb.DeleteBlogResponse{
+		BlogId: blogID,
+	}, nil
+}
+
+func (s *server) ListBlogs(req *blogpb.ListBlogsRequest, stream blogpb.BlogService_ListBlogsServer) error {
+	fmt.Printf("ListBlogs function was invoked with %v\n", req)
+	t := tenantFromContext(stream.Context())
+
+	pageSize, afterID, err := parsePage(req.GetPageSize(), req.GetCursor())
+	if err != nil {
+		return err
+	}
+
+	tags, err := normalizeTags(req.GetTags())
+	if err != nil {
+		return err
+	}
+	mask, err := parseBlogMask(req.GetReadMask(), "read_mask")
+	if err != nil {
+		return err
+	}
+
+	filter := blogFilter{
+		AuthorID: req.GetAuthorId(),
+		AfterID:  afterID,
+		Limit:    pageSize,
+		Category: strings.TrimSpace(req.GetCategory()),
+		Tags:     tags,
+		AnyTag:   req.GetTagMatch() == blogpb.TagMatch_TAG_MATCH_ANY,
+		Status:   req.GetStatus(),
+	}
+
+	//the stream context is canceled when the client cancels the request, so the store stops the listing
+	err = t.store.ListBlogs(stream.Context(), filter, func(blog *blogpb.Blog) error {
+		return stream.Send(&blogpb.ListBlogsResponse{
+			Blog:   mask.read(blog),
+			Cursor: encodeCursor(blog.GetId()),
+		})
+	})
+	if err != nil {
+		return storeError(err, "")
+	}
+
+	return nil
+}
+
+func (s *server) GetBlogHistory(ctx context.Context, req *blogpb.GetBlogHistoryRequest) (*blogpb.GetBlogHistoryResponse, error) {
+	fmt.Printf("GetBlogHistory function was invoked with %v\n", req)
+	t := tenantFromContext(ctx)
+
+	blogID := req.GetBlogId()
+	if blogID == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "The blog_id is required")
+	}
+	mask, err := parseBlogMask(req.GetReadMask(), "read_mask")
+	if err != nil {
+		return nil, err
+	}
+
+	revisions, err := t.store.ReadBlogHistory(ctx, blogID)
+	if err != nil {
+		return nil, storeError(err, blogID)
+	}
+	for i, revision := range revisions {
+		revisions[i] = mask.read(revision)
+	}
+
+	return &blogpb.GetBlogHistoryResponse{
+		Revisions: revisions,
+	}, nil
+}
+
+func (s *server) WatchBlogs(req *blogpb.WatchBlogsRequest, stream blogpb.BlogService_WatchBlogsServer) error {
+	fmt.Printf("WatchBlogs function was invoked with %v\n", req)
+	t := tenantFromContext(stream.Context())
+
+	sub, err := t.watcher.subscribe(req.GetResumeToken())
+	if err != nil {
+		if errors.Is(err, errResumeTokenExpired) {
+			return status.Errorf(codes.OutOfRange, "The resume_token is too old, read the blogs again and watch without it")
+		}
+		return status.Errorf(codes.InvalidArgument, "Invalid resume_token: %v", err)
+	}
+	defer t.watcher.unsubscribe(sub)
+
+	lastToken := req.GetResumeToken()
+	send := func(event *blogpb.WatchBlogsResponse) error {
+		if req.GetAuthorId() != "" && event.GetBlog().GetAuthorId() != req.GetAuthorId() {
+			return nil
+		}
+		err := stream.Send(event)
+		if err != nil {
+			return err
+		}
+		lastToken = event.GetResumeToken()
+		return nil
+	}
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			//the client has canceled the watch
+			return status.FromContextError(stream.Context().Err()).Err()
+		case <-sub.dropped:
+			//the events already in the buffer are still sent, the hub doesn't add more after the drop
+			for len(sub.events) > 0 {
+				err := send(<-sub.events)
+				if err != nil {
+					return err
+				}
+			}
+			return watcherTooSlow(lastToken)
+		case event := <-sub.events:
+			err := send(event)
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (s *server) SearchBlogs(ctx context.Context, req *blogpb.SearchBlogsRequest) (*blogpb.SearchBlogsResponse, error) {
+	fmt.Printf("SearchBlogs function was invoked with %v\n", req)
+	t := tenantFromContext(ctx)
+
+	query := parseSearchQuery(req.GetQuery())
+	if len(query.phrases) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "The query must have at least one word")
+	}
+
+	limit := int(req.GetLimit())
+	if limit < 0 || limit > maxSearchLimit {
+		return nil, status.Errorf(codes.InvalidArgument, "The limit must be between 0 and %v", maxSearchLimit)
+	}
+	if limit == 0 {
+		limit = defaultSearchLimit
+	}
+	mask, err := parseBlogMask(req.GetReadMask(), "read_mask")
+	if err != nil {
+		return nil, err
+	}
+
+	//the highlight and the snippet are made with the whole blog, so they are returned with any mask
+	results := t.index.search(query, req.GetAuthorId(), limit)
+	for _, result := range results {
+		result.Blog = mask.read(result.GetBlog())
+	}
+
+	return &blogpb.SearchBlogsResponse{
+		Results: results,
+	}, nil
+}
+
+func (s *server) ImportBlogs(stream blogpb.BlogService_ImportBlogsServer) error {
+
+	fmt.Printf("Received ImportBlogs RPC\n")
+	t := tenantFromContext(stream.Context())
+
+	res := &blogpb.ImportBlogsResponse{}
+	for index := int32(0); ; index++ {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			//we've reached the end of the stream
+			return stream.SendAndClose(res)
+		}
+		if err != nil {
+			return err
+		}
+
+		//the interceptors don't see the messages of a stream, so the blog is validated here
+		err = validateRequest(req)
+		if err == nil {
+			err = t.importRecord(stream.Context(), req, res)
+		}
+		if err != nil {
+			//one bad record doesn't stop the import, we only report it in the response
+			statusErr := status.Convert(err)
+			res.Failed++
+			res.Errors = append(res.Errors, &blogpb.ImportBlogError{
+				Index:    index,
+				BlogId:   req.GetBlog().GetId(),
+				AuthorId: req.GetAuthor().GetId(),
+				Code:     code.Code_name[int32(statusErr.Code())],
+				Message:  statusErr.Message(),
+			})
+		}
+	}
+}
+
+// importRecord creates the author or the blog of the request and counts it in the response
+func (t *tenant) importRecord(ctx context.Context, req *blogpb.ImportBlogsRequest, res *blogpb.ImportBlogsResponse) error {
+
+	switch record := req.GetRecord().(type) {
+	case *blogpb.ImportBlogsRequest_Author:
+		//the same author is in the exports of each of their blogs, so importing it again is not an error
+		_, err := t.createAuthor(ctx, record.Author, true)
+		if status.Code(err) == codes.AlreadyExists {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		res.ImportedAuthors++
+
+	case *blogpb.ImportBlogsRequest_Blog:
+		_, err := t.createBlog(ctx, record.Blog, true)
+		if err != nil {
+			return err
+		}
+		res.Imported++
+
+	default:
+		return status.Errorf(codes.InvalidArgument, "The blog or the author is required")
+	}
+
+	return nil
+}
+
+func (s *server) ExportBlogs(req *blogpb.ExportBlogsRequest, stream blogpb.BlogService_ExportBlogsServer) error {
+	fmt.Printf("ExportBlogs function was invoked with %v\n", req)
+	t := tenantFromContext(stream.Context())
+
+	//the authors go first, so the import creates them before their blogs
+	sendAuthor := func(author *blogpb.Author) error {
+		return stream.Send(&blogpb.ExportBlogsResponse{
+			Record: &blogpb.ExportBlogsResponse_Author{Author: author},
+		})
+	}
+	if req.GetAuthorId() != "" {
+		author, err := t.store.ReadAuthor(stream.Context(), req.GetAuthorId())
+		if err != nil {
+			return storeError(err, req.GetAuthorId())
+		}
+		err = sendAuthor(author)
+		if err != nil {
+			return err
+		}
+	} else {
+		err := t.store.ListAuthors(stream.Context(), "", 0, sendAuthor)
+		if err != nil {
+			return storeError(err, "")
+		}
+	}
+
+	filter := blogFilter{
+		AuthorID: req.GetAuthorId(),
+	}
+	err := t.store.ListBlogs(stream.Context(), filter, func(blog *blogpb.Blog) error {
+		return stream.Send(&blogpb.ExportBlogsResponse{
+			Record: &blogpb.ExportBlogsResponse_Blog{Blog: blog},
+		})
+	})
+	if err != nil {
+		return storeError(err, "")
+	}
+
+	return nil
+}
+
+// storeError converts the errors returned by the BlogStore to grpc status errors
+// the id is the one of the resource that the request is about, it is used in the error messages
+func storeError(err error, id string) error {
+	if _, ok := status.FromError(err); ok {
+		return err //it is already a grpc error, like the ones returned by stream.Send
+	}
+
+	switch {
+	case errors.Is(err, errBlogNotFound):
+		return status.Errorf(codes.NotFound, "Cannot find blog with id: %v", id)
+	case errors.Is(err, errBlogAlreadyExists):
+		return status.Errorf(codes.AlreadyExists, "A blog with id %v already exists", id)
+	case errors.Is(err, errRevisionConflict):
+		return status.Errorf(codes.Aborted, "The blog with id %v was changed by someone else, read it again and retry", id)
+	case errors.Is(err, errBlogHasComments):
+		return status.Errorf(codes.FailedPrecondition, "The blog with id %v has comments, delete them or use cascade", id)
+	case errors.Is(err, errTransactionsUnsupported):
+		return status.Errorf(codes.FailedPrecondition, "The storage can't write atomic batches, mongo must run as a replica set")
+	case errors.Is(err, errAuthorNotFound):
+		return status.Errorf(codes.NotFound, "Cannot find author with id: %v", id)
+	case errors.Is(err, errAuthorAlreadyExists):
+		return status.Errorf(codes.AlreadyExists, "An author with id %v already exists", id)
+	case errors.Is(err, errCommentNotFound):
+		return status.Errorf(codes.NotFound, "Cannot find comment with id: %v", id)
+	case errors.Is(err, context.Canceled):
+		return status.Errorf(codes.Canceled, "The request was canceled")
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Errorf(codes.DeadlineExceeded, "The deadline was exceeded")
+	}
+	return status.Errorf(codes.Internal, "Internal error: %v", err)
+}
+
+// newStore returns the BlogStore of the tenant, of the kind chosen by the -storage flag
+func newStore(ctx context.Context, storage string, tenantID string) (BlogStore, error) {
+	switch storage {
+	case "memory":
+		return newMemoryStore(), nil
+	case "file":
+		return newFileStore(tenantPath(*filePath, tenantID), *compactInterval)
+	case "mongo":
+		return newMongoStore(ctx, *mongoURI, *mongoUsername, *mongoPassword, tenantDatabase(*mongoDatabase, tenantID))
+	}
+	return nil, fmt.Errorf("unknown storage %q, it should be memory, file or mongo", storage)
 }
 
 func main() {
 
 	//if we crash the go code, we get the file name and line number
 	log.SetFlags(log.LstdFlags | log.Lshortfile)
+	flag.Parse()
 
-	lis, err := net.Listen("tcp", port)
+	sources, err := loadConfig()
+	if err != nil {
+		log.Fatalf("Failed to load the config: %v", err)
+	}
+	err = validateConfig(sources)
+	if err != nil {
+		log.Fatalf("Invalid config: %v", err)
+	}
+	printConfig(sources)
+
+	configs := []tenantConfig{{ID: defaultTenantID}}
+	if *tenantsFile != "" {
+		configs, err = loadTenants(*tenantsFile)
+		if err != nil {
+			log.Fatalf("Failed to load the tenants: %v", err)
+		}
+	}
+
+	//the commands run instead of the server, like: blog_server -storage mongo migrate -dry-run
+	switch flag.Arg(0) {
+	case "":
+	case "migrate":
+		err = migrateCommand(configs, flag.Args()[1:])
+		if err != nil {
+			log.Fatalf("Failed to migrate: %v", err)
+		}
+		return
+	default:
+		log.Fatalf("Unknown command %q, the only command is migrate", flag.Arg(0))
+	}
+
+	tenants := make([]*tenant, 0, len(configs))
+	for _, config := range configs {
+		t, err := newTenant(context.Background(), config)
+		if err != nil {
+			log.Fatalf("Failed to start the tenant %v: %v", config.ID, err)
+		}
+		tenants = append(tenants, t)
+	}
+	fmt.Println("Using storage: ", *storage)
+	fmt.Println("Tenants: ", len(tenants))
+
+	srv := newServer(tenants, *trashRetention)
+
+	lis, err := net.Listen("tcp", *listenAddress)
 	if err != nil {
 		log.Fatalf("Failed to listen: %v", err)
 	}
 
-	s := grpc.NewServer()
-	blogpb.RegisterBlogServiceServer(s, &server{})
+	idempotency := newIdempotencyStore(*idempotencyRetention)
+	//the tenant is authenticated first, so the idempotency keys are kept by tenant
+	//and the invalid requests are rejected before they are remembered
+	opts := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(srv.unaryAuthInterceptor, validationInterceptor, idempotency.unaryInterceptor),
+		grpc.StreamInterceptor(srv.streamAuthInterceptor),
+	}
+
+	// https://grpc.io/docs/guides/auth/ -> here we can see the docs explaining how to do insecure connection and with TLS/SSL
+	if *tlsCertFile != "" {
+		creds, sslErr := credentials.NewServerTLSFromFile(*tlsCertFile, *tlsKeyFile)
+		if sslErr != nil {
+			log.Fatalf("Failed loading certificates: %v", sslErr)
+		}
+		opts = append(opts, grpc.Creds(creds))
+	}
+
+	s := grpc.NewServer(opts...)
+	blogpb.RegisterBlogServiceServer(s, srv)
+
+	reflection.Register(s)
+
+	loopsCtx, stopLoops := context.WithCancel(context.Background())
+	go srv.purgeLoop(loopsCtx, *purgeInterval)
+	go srv.scheduleLoop(loopsCtx)
 
 	go func() {
-		fmt.Println("Blog server listening on port: ", port)
+		fmt.Println("Blog server listening on: ", lis.Addr())
 
 		if err := s.Serve(lis); err != nil {
 			log.Fatalf("Failed to serve: %v", err)
@@ -48,7 +737,14 @@ func main() {
 	<-ch
 	fmt.Println("\nStopping the server")
 	s.Stop()
+	stopLoops()
 	fmt.Println("Closing the listener")
 	lis.Close()
+	fmt.Println("Closing the storage")
+	ctx, cancel := context.WithTimeout(context.Background(), *shutdownTimeout)
+	defer cancel()
+	for _, t := range tenants {
+		t.store.Close(ctx)
+	}
 	fmt.Println("End of program")
 }
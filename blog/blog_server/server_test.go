@@ -0,0 +1,335 @@
+package main
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/diegoclair/grpc-go-course/blog/blogpb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// newTestTenant returns a tenant that saves the blogs in memory
+func newTestTenant(t *testing.T, id string, apiKeys ...string) *tenant {
+	t.Helper()
+
+	attachments, err := newAttachmentStore(t.TempDir(), 1<<20)
+	if err != nil {
+		t.Fatalf("newAttachmentStore: %v", err)
+	}
+	return &tenant{
+		id:          id,
+		apiKeys:     apiKeys,
+		store:       newMemoryStore(),
+		attachments: attachments,
+		watcher:     newWatchHub(),
+		index:       newSearchIndex(),
+		renders:     newRenderCache(),
+	}
+}
+
+// newTestServer returns a server with only the default tenant, and the context of its requests
+func newTestServer(t *testing.T) (*server, context.Context) {
+	t.Helper()
+
+	tn := newTestTenant(t, defaultTenantID)
+	s := newServer([]*tenant{tn}, *trashRetention)
+	return s, context.WithValue(context.Background(), tenantContextKey{}, tn)
+}
+
+// createTestAuthor creates an author for the blogs of the test and returns its id
+func createTestAuthor(t *testing.T, s *server, ctx context.Context) string {
+	t.Helper()
+
+	res, err := s.CreateAuthor(ctx, &blogpb.CreateAuthorRequest{Author: &blogpb.Author{Name: "Diego"}})
+	if err != nil {
+		t.Fatalf("CreateAuthor: %v", err)
+	}
+	return res.GetAuthor().GetId()
+}
+
+func createTestBlog(t *testing.T, s *server, ctx context.Context, authorID, title string) *blogpb.Blog {
+	t.Helper()
+
+	res, err := s.CreateBlog(ctx, &blogpb.CreateBlogRequest{Blog: &blogpb.Blog{AuthorId: authorID, Title: title, Content: "content of " + title}})
+	if err != nil {
+		t.Fatalf("CreateBlog: %v", err)
+	}
+	return res.GetBlog()
+}
+
+// listBlogsStream keeps the blogs sent by ListBlogs
+type listBlogsStream struct {
+	grpc.ServerStream
+	ctx       context.Context
+	responses []*blogpb.ListBlogsResponse
+}
+
+func (s *listBlogsStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *listBlogsStream) Send(res *blogpb.ListBlogsResponse) error {
+	s.responses = append(s.responses, res)
+	return nil
+}
+
+func TestCreateAndReadBlog(t *testing.T) {
+	s, ctx := newTestServer(t)
+	authorID := createTestAuthor(t, s, ctx)
+
+	created := createTestBlog(t, s, ctx, authorID, "My First Blog")
+	if created.GetId() == "" || created.GetRevision() != 1 || created.GetCreatedAt() == nil {
+		t.Fatalf("the created blog should have an id, the revision 1 and the created_at, got %v", created)
+	}
+	if created.GetStatus() != blogpb.BlogStatus_BLOG_STATUS_DRAFT {
+		t.Errorf("a new blog should be a draft, got %v", created.GetStatus())
+	}
+
+	res, err := s.ReadBlog(ctx, &blogpb.ReadBlogRequest{BlogId: created.GetId()})
+	if err != nil {
+		t.Fatalf("ReadBlog: %v", err)
+	}
+	if !proto.Equal(res.GetBlog(), created) {
+		t.Errorf("ReadBlog returned %v, want %v", res.GetBlog(), created)
+	}
+}
+
+func TestCreateBlogErrors(t *testing.T) {
+	s, ctx := newTestServer(t)
+	authorID := createTestAuthor(t, s, ctx)
+	existing := createTestBlog(t, s, ctx, authorID, "taken")
+
+	tests := []struct {
+		name string
+		blog *blogpb.Blog
+		code codes.Code
+	}{
+		{"without blog", nil, codes.InvalidArgument},
+		{"without title", &blogpb.Blog{AuthorId: authorID}, codes.InvalidArgument},
+		{"without author", &blogpb.Blog{Title: "title"}, codes.InvalidArgument},
+		{"unknown author", &blogpb.Blog{AuthorId: "unknown", Title: "title"}, codes.FailedPrecondition},
+		{"repeated id", &blogpb.Blog{Id: existing.GetId(), AuthorId: authorID, Title: "title"}, codes.AlreadyExists},
+	}
+	for _, test := range tests {
+		_, err := s.CreateBlog(ctx, &blogpb.CreateBlogRequest{Blog: test.blog})
+		if status.Code(err) != test.code {
+			t.Errorf("%v: CreateBlog returned %v, want %v", test.name, err, test.code)
+		}
+	}
+}
+
+func TestReadBlogNotFound(t *testing.T) {
+	s, ctx := newTestServer(t)
+
+	_, err := s.ReadBlog(ctx, &blogpb.ReadBlogRequest{BlogId: "unknown"})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("ReadBlog returned %v, want NOT_FOUND", err)
+	}
+	_, err = s.ReadBlog(ctx, &blogpb.ReadBlogRequest{})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("ReadBlog without id returned %v, want INVALID_ARGUMENT", err)
+	}
+}
+
+func TestUpdateBlogRevision(t *testing.T) {
+	s, ctx := newTestServer(t)
+	authorID := createTestAuthor(t, s, ctx)
+	created := createTestBlog(t, s, ctx, authorID, "before")
+
+	blog := proto.Clone(created).(*blogpb.Blog)
+	blog.Title = "after"
+	res, err := s.UpdateBlog(ctx, &blogpb.UpdateBlogRequest{Blog: blog, ExpectedRevision: 1})
+	if err != nil {
+		t.Fatalf("UpdateBlog: %v", err)
+	}
+	if res.GetBlog().GetTitle() != "after" || res.GetBlog().GetRevision() != 2 {
+		t.Errorf("the updated blog should have the new title and the revision 2, got %v", res.GetBlog())
+	}
+	if !proto.Equal(res.GetBlog().GetCreatedAt(), created.GetCreatedAt()) {
+		t.Errorf("the update changed the created_at from %v to %v", created.GetCreatedAt(), res.GetBlog().GetCreatedAt())
+	}
+
+	//the client read the revision 1, so its update would overwrite the one above
+	blog.Title = "stale"
+	_, err = s.UpdateBlog(ctx, &blogpb.UpdateBlogRequest{Blog: blog, ExpectedRevision: 1})
+	if status.Code(err) != codes.Aborted {
+		t.Errorf("UpdateBlog with an old revision returned %v, want ABORTED", err)
+	}
+	_, err = s.UpdateBlog(ctx, &blogpb.UpdateBlogRequest{Blog: blog})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("UpdateBlog without the expected_revision returned %v, want INVALID_ARGUMENT", err)
+	}
+
+	history, err := s.GetBlogHistory(ctx, &blogpb.GetBlogHistoryRequest{BlogId: created.GetId()})
+	if err != nil {
+		t.Fatalf("GetBlogHistory: %v", err)
+	}
+	if len(history.GetRevisions()) != 2 || history.GetRevisions()[0].GetTitle() != "before" {
+		t.Errorf("the history should have the 2 revisions from the oldest, got %v", history.GetRevisions())
+	}
+}
+
+func TestDeleteBlog(t *testing.T) {
+	s, ctx := newTestServer(t)
+	authorID := createTestAuthor(t, s, ctx)
+	blog := createTestBlog(t, s, ctx, authorID, "to delete")
+
+	_, err := s.DeleteBlog(ctx, &blogpb.DeleteBlogRequest{BlogId: blog.GetId()})
+	if err != nil {
+		t.Fatalf("DeleteBlog: %v", err)
+	}
+	_, err = s.ReadBlog(ctx, &blogpb.ReadBlogRequest{BlogId: blog.GetId()})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("ReadBlog of a blog in the trash returned %v, want NOT_FOUND", err)
+	}
+
+	//the blog in the trash can still be removed
+	_, err = s.DeleteBlog(ctx, &blogpb.DeleteBlogRequest{BlogId: blog.GetId(), Permanent: true})
+	if err != nil {
+		t.Fatalf("DeleteBlog permanent: %v", err)
+	}
+	_, err = s.DeleteBlog(ctx, &blogpb.DeleteBlogRequest{BlogId: blog.GetId(), Permanent: true})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("DeleteBlog of a removed blog returned %v, want NOT_FOUND", err)
+	}
+}
+
+func TestListBlogsPages(t *testing.T) {
+	s, ctx := newTestServer(t)
+	authorID := createTestAuthor(t, s, ctx)
+	otherID := createTestAuthor(t, s, ctx)
+
+	want := make(map[string]bool)
+	for _, title := range []string{"a", "b", "c"} {
+		want[createTestBlog(t, s, ctx, authorID, title).GetId()] = true
+	}
+	createTestBlog(t, s, ctx, otherID, "of the other author")
+
+	got := make([]string, 0)
+	cursor := ""
+	for page := 0; page < 3; page++ {
+		stream := &listBlogsStream{ctx: ctx}
+		err := s.ListBlogs(&blogpb.ListBlogsRequest{AuthorId: authorID, PageSize: 2, Cursor: cursor}, stream)
+		if err != nil {
+			t.Fatalf("ListBlogs: %v", err)
+		}
+		if len(stream.responses) == 0 {
+			break
+		}
+		for _, res := range stream.responses {
+			got = append(got, res.GetBlog().GetId())
+		}
+		cursor = stream.responses[len(stream.responses)-1].GetCursor()
+	}
+
+	if len(got) != len(want) {
+		t.Fatalf("ListBlogs returned %v, want the %v blogs of the author", got, len(want))
+	}
+	for i, id := range got {
+		if !want[id] || (i > 0 && got[i-1] >= id) {
+			t.Errorf("ListBlogs returned %v, want the blogs of the author ordered by id", got)
+			break
+		}
+	}
+
+	err := s.ListBlogs(&blogpb.ListBlogsRequest{Cursor: "not a cursor"}, &listBlogsStream{ctx: ctx})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("ListBlogs with a malformed cursor returned %v, want INVALID_ARGUMENT", err)
+	}
+	err = s.ListBlogs(&blogpb.ListBlogsRequest{PageSize: maxPageSize + 1}, &listBlogsStream{ctx: ctx})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("ListBlogs with a page_size over the max returned %v, want INVALID_ARGUMENT", err)
+	}
+}
+
+// exportBlogsStream keeps the records sent by ExportBlogs
+type exportBlogsStream struct {
+	grpc.ServerStream
+	ctx       context.Context
+	responses []*blogpb.ExportBlogsResponse
+}
+
+func (s *exportBlogsStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *exportBlogsStream) Send(res *blogpb.ExportBlogsResponse) error {
+	s.responses = append(s.responses, res)
+	return nil
+}
+
+// importBlogsStream sends the requests to ImportBlogs and keeps its response
+type importBlogsStream struct {
+	grpc.ServerStream
+	ctx      context.Context
+	requests []*blogpb.ImportBlogsRequest
+	response *blogpb.ImportBlogsResponse
+}
+
+func (s *importBlogsStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *importBlogsStream) Recv() (*blogpb.ImportBlogsRequest, error) {
+	if len(s.requests) == 0 {
+		return nil, io.EOF
+	}
+	req := s.requests[0]
+	s.requests = s.requests[1:]
+	return req, nil
+}
+
+func (s *importBlogsStream) SendAndClose(res *blogpb.ImportBlogsResponse) error {
+	s.response = res
+	return nil
+}
+
+func TestExportAndImportBlogs(t *testing.T) {
+	s, ctx := newTestServer(t)
+	authorID := createTestAuthor(t, s, ctx)
+	blog := createTestBlog(t, s, ctx, authorID, "exported")
+
+	export := &exportBlogsStream{ctx: ctx}
+	err := s.ExportBlogs(&blogpb.ExportBlogsRequest{AuthorId: authorID}, export)
+	if err != nil {
+		t.Fatalf("ExportBlogs: %v", err)
+	}
+	if len(export.responses) != 2 || export.responses[0].GetAuthor().GetId() != authorID || export.responses[1].GetBlog().GetId() != blog.GetId() {
+		t.Fatalf("ExportBlogs should send the author before the blog, got %v", export.responses)
+	}
+
+	//the author is sent twice like in two exports, the blog without author fails alone
+	requests := make([]*blogpb.ImportBlogsRequest, 0)
+	for _, res := range append(export.responses, export.responses[0]) {
+		if res.GetAuthor() != nil {
+			requests = append(requests, &blogpb.ImportBlogsRequest{Record: &blogpb.ImportBlogsRequest_Author{Author: res.GetAuthor()}})
+		} else {
+			requests = append(requests, &blogpb.ImportBlogsRequest{Record: &blogpb.ImportBlogsRequest_Blog{Blog: res.GetBlog()}})
+		}
+	}
+	requests = append(requests, &blogpb.ImportBlogsRequest{Record: &blogpb.ImportBlogsRequest_Blog{Blog: &blogpb.Blog{AuthorId: "unknown", Title: "orphan"}}})
+
+	target, targetCtx := newTestServer(t)
+	stream := &importBlogsStream{ctx: targetCtx, requests: requests}
+	err = target.ImportBlogs(stream)
+	if err != nil {
+		t.Fatalf("ImportBlogs: %v", err)
+	}
+	res := stream.response
+	if res.GetImportedAuthors() != 1 || res.GetImported() != 1 || res.GetFailed() != 1 || res.GetErrors()[0].GetIndex() != 3 {
+		t.Errorf("ImportBlogs should import the author and the blog and fail the orphan, got %v", res)
+	}
+
+	read, err := target.ReadBlog(targetCtx, &blogpb.ReadBlogRequest{BlogId: blog.GetId()})
+	if err != nil || !proto.Equal(read.GetBlog(), blog) {
+		t.Errorf("the imported blog should be the exported one, got %v (%v)", read, err)
+	}
+	author, err := target.GetAuthor(targetCtx, &blogpb.GetAuthorRequest{AuthorId: authorID})
+	if err != nil || !proto.Equal(author.GetAuthor(), export.responses[0].GetAuthor()) {
+		t.Errorf("the imported author should be the exported one, got %v (%v)", author, err)
+	}
+}
@@ -0,0 +1,206 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/diegoclair/grpc-go-course/blog/blogpb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// The stats are calculated when they are requested, reading all the published blogs of the tenant that are not in the trash,
+// the drafts, the blogs in review and the archived ones are not counted.
+// The views are saved by the store apart from the blogs, so a view doesn't create a new revision.
+
+const (
+	dateLayout         = "2006-01-02"
+	maxStatsDays       = 366
+	defaultViewedLimit = 10
+	maxViewedLimit     = 100
+)
+
+func (s *server) RecordView(ctx context.Context, req *blogpb.RecordViewRequest) (*blogpb.RecordViewResponse, error) {
+	fmt.Printf("RecordView function was invoked with %v\n", req)
+	t := tenantFromContext(ctx)
+
+	blogID := req.GetBlogId()
+	if blogID == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "The blog_id is required")
+	}
+
+	views, err := t.store.RecordView(ctx, blogID)
+	if err != nil {
+		return nil, storeError(err, blogID)
+	}
+
+	return &blogpb.RecordViewResponse{
+		ViewCount: &blogpb.BlogViewCount{
+			BlogId: blogID,
+			Views:  views,
+		},
+	}, nil
+}
+
+func (s *server) GetAuthorStats(ctx context.Context, req *blogpb.GetAuthorStatsRequest) (*blogpb.GetAuthorStatsResponse, error) {
+	fmt.Printf("GetAuthorStats function was invoked with %v\n", req)
+	t := tenantFromContext(ctx)
+
+	views, err := t.store.ViewCounts(ctx)
+	if err != nil {
+		return nil, storeError(err, "")
+	}
+
+	stats := make(map[string]*blogpb.AuthorStats)
+	filter := blogFilter{AuthorID: req.GetAuthorId(), Status: blogpb.BlogStatus_BLOG_STATUS_PUBLISHED}
+	err = t.store.ListBlogs(ctx, filter, func(blog *blogpb.Blog) error {
+		author, ok := stats[blog.GetAuthorId()]
+		if !ok {
+			author = &blogpb.AuthorStats{AuthorId: blog.GetAuthorId()}
+			stats[blog.GetAuthorId()] = author
+		}
+		author.BlogCount++
+		author.WordCount += int64(len(strings.Fields(blog.GetContent())))
+		author.ViewCount += views[blog.GetId()]
+		return nil
+	})
+	if err != nil {
+		return nil, storeError(err, "")
+	}
+
+	authors := make([]*blogpb.AuthorStats, 0, len(stats))
+	for _, author := range stats {
+		authors = append(authors, author)
+	}
+	sort.Slice(authors, func(i, j int) bool {
+		if authors[i].GetBlogCount() != authors[j].GetBlogCount() {
+			return authors[i].GetBlogCount() > authors[j].GetBlogCount()
+		}
+		return authors[i].GetAuthorId() < authors[j].GetAuthorId()
+	})
+
+	return &blogpb.GetAuthorStatsResponse{
+		Authors: authors,
+	}, nil
+}
+
+func (s *server) GetPostsPerDay(ctx context.Context, req *blogpb.GetPostsPerDayRequest) (*blogpb.GetPostsPerDayResponse, error) {
+	fmt.Printf("GetPostsPerDay function was invoked with %v\n", req)
+	t := tenantFromContext(ctx)
+
+	location := time.UTC
+	if req.GetTimeZone() != "" {
+		var err error
+		location, err = time.LoadLocation(req.GetTimeZone())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "The time_zone %q is unknown, it should be like America/Sao_Paulo", req.GetTimeZone())
+		}
+	}
+
+	start, err := time.ParseInLocation(dateLayout, req.GetStartDate(), location)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "The start_date must be like 2020-12-31")
+	}
+	end, err := time.ParseInLocation(dateLayout, req.GetEndDate(), location)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "The end_date must be like 2020-12-31")
+	}
+	if end.Before(start) {
+		return nil, status.Errorf(codes.InvalidArgument, "The end_date can't be before the start_date")
+	}
+
+	//the days are listed with AddDate, because a day can have 23 or 25 hours when the daylight saving time changes
+	days := make([]*blogpb.DayCount, 0)
+	index := make(map[string]*blogpb.DayCount)
+	for day := start; !day.After(end); day = day.AddDate(0, 0, 1) {
+		if len(days) == maxStatsDays {
+			return nil, status.Errorf(codes.InvalidArgument, "The range can have up to %v days", maxStatsDays)
+		}
+		count := &blogpb.DayCount{Date: day.Format(dateLayout)}
+		days = append(days, count)
+		index[count.GetDate()] = count
+	}
+
+	res := &blogpb.GetPostsPerDayResponse{
+		Days: days,
+	}
+	filter := blogFilter{AuthorID: req.GetAuthorId(), Status: blogpb.BlogStatus_BLOG_STATUS_PUBLISHED}
+	err = t.store.ListBlogs(ctx, filter, func(blog *blogpb.Blog) error {
+		if count, ok := index[publishedAt(blog).In(location).Format(dateLayout)]; ok {
+			count.Count++
+			res.Total++
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, storeError(err, "")
+	}
+
+	return res, nil
+}
+
+func (s *server) ListMostViewedBlogs(ctx context.Context, req *blogpb.ListMostViewedBlogsRequest) (*blogpb.ListMostViewedBlogsResponse, error) {
+	fmt.Printf("ListMostViewedBlogs function was invoked with %v\n", req)
+	t := tenantFromContext(ctx)
+
+	limit := int(req.GetLimit())
+	if limit < 0 || limit > maxViewedLimit {
+		return nil, status.Errorf(codes.InvalidArgument, "The limit must be between 0 and %v", maxViewedLimit)
+	}
+	if limit == 0 {
+		limit = defaultViewedLimit
+	}
+	mask, err := parseBlogMask(req.GetReadMask(), "read_mask")
+	if err != nil {
+		return nil, err
+	}
+
+	views, err := t.store.ViewCounts(ctx)
+	if err != nil {
+		return nil, storeError(err, "")
+	}
+
+	//the listing skips the blogs in the trash, that are still in the view counts
+	viewed := make([]*blogpb.ViewedBlog, 0)
+	filter := blogFilter{AuthorID: req.GetAuthorId(), Status: blogpb.BlogStatus_BLOG_STATUS_PUBLISHED}
+	err = t.store.ListBlogs(ctx, filter, func(blog *blogpb.Blog) error {
+		if views[blog.GetId()] > 0 {
+			viewed = append(viewed, &blogpb.ViewedBlog{
+				Blog:  blog,
+				Views: views[blog.GetId()],
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, storeError(err, "")
+	}
+
+	sort.Slice(viewed, func(i, j int) bool {
+		if viewed[i].GetViews() != viewed[j].GetViews() {
+			return viewed[i].GetViews() > viewed[j].GetViews()
+		}
+		return viewed[i].GetBlog().GetId() < viewed[j].GetBlog().GetId()
+	})
+	if len(viewed) > limit {
+		viewed = viewed[:limit]
+	}
+	for _, v := range viewed {
+		v.Blog = mask.read(v.GetBlog())
+	}
+
+	return &blogpb.ListMostViewedBlogsResponse{
+		Blogs: viewed,
+	}, nil
+}
+
+// publishedAt returns when the blog was published, the blogs saved before the workflow were published when created
+func publishedAt(blog *blogpb.Blog) time.Time {
+	if blog.GetPublishedAt() != nil {
+		return blog.GetPublishedAt().AsTime()
+	}
+	return blog.GetCreatedAt().AsTime()
+}
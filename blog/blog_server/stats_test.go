@@ -0,0 +1,85 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/diegoclair/grpc-go-course/blog/blogpb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestBlogStats(t *testing.T) {
+	s, ctx := newTestServer(t)
+	store := tenantFromContext(ctx).store
+
+	//the blogs are saved in the store, so we choose their dates, the b is counted in the day it was published
+	//and the draft d is not counted at all
+	blogs := []*blogpb.Blog{
+		{Id: "a", AuthorId: "x", Title: "a", Content: "one two three", CreatedAt: timestamppb.New(time.Date(2020, 12, 31, 23, 30, 0, 0, time.UTC))},
+		{Id: "b", AuthorId: "x", Title: "b", Content: "four five", CreatedAt: timestamppb.New(time.Date(2020, 12, 20, 10, 0, 0, 0, time.UTC)),
+			Status: blogpb.BlogStatus_BLOG_STATUS_PUBLISHED, PublishedAt: timestamppb.New(time.Date(2021, 1, 1, 10, 0, 0, 0, time.UTC))},
+		{Id: "c", AuthorId: "y", Title: "c", Content: "six", CreatedAt: timestamppb.New(time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC))},
+		{Id: "d", AuthorId: "x", Title: "d", Content: "seven", CreatedAt: timestamppb.New(time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC)), Status: blogpb.BlogStatus_BLOG_STATUS_DRAFT},
+	}
+	for _, blog := range blogs {
+		_, err := store.CreateBlog(ctx, blog)
+		if err != nil {
+			t.Fatalf("CreateBlog: %v", err)
+		}
+	}
+	for _, id := range []string{"a", "a", "c", "d", "d", "d"} {
+		_, err := s.RecordView(ctx, &blogpb.RecordViewRequest{BlogId: id})
+		if err != nil {
+			t.Fatalf("RecordView: %v", err)
+		}
+	}
+	_, err := s.RecordView(ctx, &blogpb.RecordViewRequest{BlogId: "unknown"})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("RecordView of an unknown blog returned %v, want NOT_FOUND", err)
+	}
+
+	authors, err := s.GetAuthorStats(ctx, &blogpb.GetAuthorStatsRequest{})
+	if err != nil {
+		t.Fatalf("GetAuthorStats: %v", err)
+	}
+	if got := authors.GetAuthors(); len(got) != 2 || got[0].GetAuthorId() != "x" || got[0].GetBlogCount() != 2 || got[0].GetWordCount() != 5 || got[0].GetViewCount() != 2 {
+		t.Errorf("GetAuthorStats returned %v", got)
+	}
+
+	tests := []struct {
+		timeZone string
+		want     []int64
+	}{
+		{"", []int64{1, 2}},
+		{"Asia/Tokyo", []int64{0, 3}},
+	}
+	for _, test := range tests {
+		res, err := s.GetPostsPerDay(ctx, &blogpb.GetPostsPerDayRequest{StartDate: "2020-12-31", EndDate: "2021-01-01", TimeZone: test.timeZone})
+		if err != nil {
+			t.Fatalf("GetPostsPerDay: %v", err)
+		}
+		if len(res.GetDays()) != len(test.want) || res.GetTotal() != 3 {
+			t.Fatalf("GetPostsPerDay in %q returned %v, want %v", test.timeZone, res, test.want)
+		}
+		for i, day := range res.GetDays() {
+			if day.GetCount() != test.want[i] {
+				t.Errorf("GetPostsPerDay in %q returned %v, want %v", test.timeZone, res.GetDays(), test.want)
+				break
+			}
+		}
+	}
+	_, err = s.GetPostsPerDay(ctx, &blogpb.GetPostsPerDayRequest{StartDate: "2021-01-02", EndDate: "2021-01-01"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("GetPostsPerDay with the end before the start returned %v, want INVALID_ARGUMENT", err)
+	}
+
+	viewed, err := s.ListMostViewedBlogs(ctx, &blogpb.ListMostViewedBlogsRequest{})
+	if err != nil {
+		t.Fatalf("ListMostViewedBlogs: %v", err)
+	}
+	if got := viewed.GetBlogs(); len(got) != 2 || got[0].GetBlog().GetId() != "a" || got[0].GetViews() != 2 || got[1].GetBlog().GetId() != "c" {
+		t.Errorf("ListMostViewedBlogs returned %v, want a and c", got)
+	}
+}
@@ -0,0 +1,193 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/diegoclair/grpc-go-course/blog/blogpb"
+	"google.golang.org/protobuf/proto"
+)
+
+var (
+	errBlogNotFound      = errors.New("blog not found")
+	errBlogAlreadyExists = errors.New("blog already exists")
+	errRevisionConflict  = errors.New("blog revision conflict")
+	errBlogHasComments   = errors.New("blog has comments")
+
+	errTransactionsUnsupported = errors.New("the storage doesn't support transactions")
+
+	errAuthorNotFound      = errors.New("author not found")
+	errAuthorAlreadyExists = errors.New("author already exists")
+	errCommentNotFound     = errors.New("comment not found")
+)
+
+// blogFilter are the conditions used to list the blogs
+type blogFilter struct {
+	AuthorID string // when filled only blogs of this author are returned
+	AfterID  string // when filled only blogs with a greater id are returned
+	Limit    int    // max number of blogs, 0 means no limit
+	Deleted  bool   // when true only the blogs in the trash are returned, otherwise only the active ones
+	Category string // when filled only blogs of this category are returned
+	Tags     []string
+	AnyTag   bool              // when true the blogs need only one of the Tags, otherwise all of them
+	Status   blogpb.BlogStatus // when filled only blogs with this status are returned
+}
+
+// matches checks the blog against all the conditions, except the Limit
+func (f blogFilter) matches(blog *blogpb.Blog) bool {
+	if f.AuthorID != "" && blog.GetAuthorId() != f.AuthorID {
+		return false
+	}
+	if f.AfterID != "" && blog.GetId() <= f.AfterID {
+		return false
+	}
+	if (blog.GetDeletedAt() != nil) != f.Deleted {
+		return false
+	}
+	if f.Category != "" && blog.GetCategory() != f.Category {
+		return false
+	}
+	if f.Status != blogpb.BlogStatus_BLOG_STATUS_UNSPECIFIED && blogStatus(blog) != f.Status {
+		return false
+	}
+	if len(f.Tags) == 0 {
+		return true
+	}
+
+	found := 0
+	for _, tag := range f.Tags {
+		if hasTag(blog, tag) {
+			found++
+		}
+	}
+	if f.AnyTag {
+		return found > 0
+	}
+	return found == len(f.Tags)
+}
+
+// blogWrite is a change of WriteBlogs, the blog is saved as it is, with the revision and timestamps already filled
+type blogWrite struct {
+	Blog             *blogpb.Blog
+	Create           bool  // when true the blog can't exist, even in the trash
+	ExpectedRevision int64 // when Create is false, the revision that the stored blog must have, outside of the trash
+}
+
+// batchError is returned by WriteBlogs when one of the writes can't be done, Err is why
+type batchError struct {
+	Index int
+	Err   error
+}
+
+func (e *batchError) Error() string {
+	return fmt.Sprintf("write %v: %v", e.Index, e.Err)
+}
+
+func (e *batchError) Unwrap() error {
+	return e.Err
+}
+
+// blogUsage is what the blogs of a store use from the tenant quota
+type blogUsage struct {
+	Blogs        int64
+	ContentBytes int64
+}
+
+func hasTag(blog *blogpb.Blog, tag string) bool {
+	for _, t := range blog.GetTags() {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
+
+// BlogStore is the persistence layer used by the BlogService handlers.
+// The handlers validate the requests, so the implementations only need to care about the data.
+// A blog in the trash (with deleted_at) is not found by the methods, except by the ones about the trash.
+type BlogStore interface {
+	// CreateBlog stores a new blog, the blog id must be already filled
+	CreateBlog(ctx context.Context, blog *blogpb.Blog) (*blogpb.Blog, error)
+	ReadBlog(ctx context.Context, blogID string) (*blogpb.Blog, error)
+	// UpdateBlog replaces the blog only if its current revision is the expectedRevision,
+	// otherwise it returns errRevisionConflict. The previous revision is kept in the history,
+	// the new one gets the next revision number and keeps the created_at of the stored blog.
+	UpdateBlog(ctx context.Context, blog *blogpb.Blog, expectedRevision int64) (*blogpb.Blog, error)
+	// DeleteBlog removes the blog and its history, even if it is in the trash, returning the blog as it was before the delete.
+	// If the blog has comments, they are removed when cascade is true, otherwise it returns errBlogHasComments.
+	DeleteBlog(ctx context.Context, blogID string, cascade bool) (*blogpb.Blog, error)
+	// TrashBlog moves the blog to the trash, setting its deleted_at
+	TrashBlog(ctx context.Context, blogID string, deletedAt time.Time) (*blogpb.Blog, error)
+	// RestoreBlog moves the blog back from the trash, if it is not in the trash it returns errBlogNotFound
+	RestoreBlog(ctx context.Context, blogID string) (*blogpb.Blog, error)
+	// PurgeBlogs removes the blogs that were moved to the trash before deletedBefore, with their comments
+	PurgeBlogs(ctx context.Context, deletedBefore time.Time) ([]*blogpb.Blog, error)
+	// ListBlogs calls fn for each blog that matches the filter, ordered by id.
+	// If fn returns an error, the listing stops and the error is returned.
+	ListBlogs(ctx context.Context, filter blogFilter, fn func(blog *blogpb.Blog) error) error
+	// CountTags returns how many blogs that match the filter have each tag, the AfterID and Limit are ignored
+	CountTags(ctx context.Context, filter blogFilter) (map[string]int64, error)
+	// Usage returns how many blogs are stored, including the ones in the trash, and the size of their contents
+	Usage(ctx context.Context) (blogUsage, error)
+	// RecordView adds one view to the blog and returns its views, if it is in the trash it returns errBlogNotFound
+	RecordView(ctx context.Context, blogID string) (int64, error)
+	// ViewCounts returns the views of each blog that has any, including the blogs in the trash
+	ViewCounts(ctx context.Context) (map[string]int64, error)
+	// WriteBlogs saves all the writes or none of them. Each write is checked as if the previous ones were already saved,
+	// if one fails it returns a *batchError with errBlogAlreadyExists, errBlogNotFound or errRevisionConflict.
+	// A blog that gets a new revision has the previous one kept in the history, like in UpdateBlog.
+	WriteBlogs(ctx context.Context, writes []blogWrite) error
+	// ReadBlogHistory returns all the revisions of the blog, from the oldest to the current one
+	ReadBlogHistory(ctx context.Context, blogID string) ([]*blogpb.Blog, error)
+
+	// CreateAuthor stores a new author, the author id must be already filled
+	CreateAuthor(ctx context.Context, author *blogpb.Author) (*blogpb.Author, error)
+	ReadAuthor(ctx context.Context, authorID string) (*blogpb.Author, error)
+	// ListAuthors calls fn for each author with an id greater than afterID, ordered by id
+	ListAuthors(ctx context.Context, afterID string, limit int, fn func(author *blogpb.Author) error) error
+
+	// CreateComment stores a new comment, the comment id must be already filled.
+	// If the blog of the comment doesn't exist, it returns errBlogNotFound.
+	CreateComment(ctx context.Context, comment *blogpb.Comment) (*blogpb.Comment, error)
+	// ListComments calls fn for each comment of the blog with an id greater than afterID, ordered by id
+	ListComments(ctx context.Context, blogID, afterID string, limit int, fn func(comment *blogpb.Comment) error) error
+	DeleteComment(ctx context.Context, commentID string) error
+
+	Close(ctx context.Context) error
+}
+
+// schemaStore is implemented by the stores whose saved data must change with the Blog message, like the mongo documents
+// and indexes. The memory store has nothing saved and the file store saves protobuf, that already reads the old records.
+type schemaStore interface {
+	// Migrate applies the pending migrations in order, writing what was done in out, and returns how many they were.
+	// With dryRun nothing is changed, it only writes what would be done.
+	Migrate(ctx context.Context, dryRun bool, out io.Writer) (int, error)
+}
+
+// nextRevision checks the expectedRevision and returns the blog that will replace the current one
+func nextRevision(current, blog *blogpb.Blog, expectedRevision int64) (*blogpb.Blog, error) {
+	if current.GetRevision() != expectedRevision {
+		return nil, errRevisionConflict
+	}
+
+	data := proto.Clone(blog).(*blogpb.Blog)
+	data.Revision = current.GetRevision() + 1
+	data.CreatedAt = current.GetCreatedAt()
+
+	return data, nil
+}
+
+// newID returns a random hex id with the same size of a mongo ObjectID
+func newID() (string, error) {
+	b := make([]byte, 12)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
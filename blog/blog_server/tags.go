@@ -0,0 +1,94 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/diegoclair/grpc-go-course/blog/blogpb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	maxTags           = 20
+	maxTagLength      = 50
+	maxCategoryLength = 100
+)
+
+func (s *server) ListTags(ctx context.Context, req *blogpb.ListTagsRequest) (*blogpb.ListTagsResponse, error) {
+	fmt.Printf("ListTags function was invoked with %v\n", req)
+	t := tenantFromContext(ctx)
+
+	filter := blogFilter{
+		AuthorID: req.GetAuthorId(),
+		Category: strings.TrimSpace(req.GetCategory()),
+	}
+	counts, err := t.store.CountTags(ctx, filter)
+	if err != nil {
+		return nil, storeError(err, "")
+	}
+
+	tags := make([]*blogpb.TagCount, 0, len(counts))
+	for tag, count := range counts {
+		tags = append(tags, &blogpb.TagCount{
+			Tag:   tag,
+			Count: count,
+		})
+	}
+	sort.Slice(tags, func(i, j int) bool {
+		if tags[i].GetCount() != tags[j].GetCount() {
+			return tags[i].GetCount() > tags[j].GetCount()
+		}
+		return tags[i].GetTag() < tags[j].GetTag()
+	})
+
+	return &blogpb.ListTagsResponse{
+		Tags: tags,
+	}, nil
+}
+
+// normalizeFacets checks the tags and the category of the blog, leaving them in the format that is saved
+func normalizeFacets(blog *blogpb.Blog) error {
+
+	tags, err := normalizeTags(blog.GetTags())
+	if err != nil {
+		return err
+	}
+	if len(tags) > maxTags {
+		return status.Errorf(codes.InvalidArgument, "The blog can't have more than %v tags", maxTags)
+	}
+	blog.Tags = tags
+
+	blog.Category = strings.TrimSpace(blog.GetCategory())
+	if len(blog.GetCategory()) > maxCategoryLength {
+		return status.Errorf(codes.InvalidArgument, "The blog category can't have more than %v bytes", maxCategoryLength)
+	}
+
+	return nil
+}
+
+// normalizeTags returns the tags in lower case, without duplicates and sorted, so "Go" and "go " are the same tag
+func normalizeTags(tags []string) ([]string, error) {
+
+	seen := make(map[string]struct{})
+	result := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "The tags can't be empty")
+		}
+		if len(tag) > maxTagLength {
+			return nil, status.Errorf(codes.InvalidArgument, "The tag %q has more than %v bytes", tag, maxTagLength)
+		}
+		if _, ok := seen[tag]; ok {
+			continue
+		}
+		seen[tag] = struct{}{}
+		result = append(result, tag)
+	}
+	sort.Strings(result)
+
+	return result, nil
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/diegoclair/grpc-go-course/blog/blogpb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestBlogTagsAndFacets(t *testing.T) {
+	s, ctx := newTestServer(t)
+	authorID := createTestAuthor(t, s, ctx)
+
+	blogs := []*blogpb.Blog{
+		{AuthorId: authorID, Title: "a", Category: " Backend ", Tags: []string{"Go ", "go", "grpc"}},
+		{AuthorId: authorID, Title: "b", Category: "Backend", Tags: []string{"go"}},
+		{AuthorId: authorID, Title: "c", Category: "Frontend", Tags: []string{"css"}},
+	}
+	for _, blog := range blogs {
+		_, err := s.CreateBlog(ctx, &blogpb.CreateBlogRequest{Blog: blog})
+		if err != nil {
+			t.Fatalf("CreateBlog: %v", err)
+		}
+	}
+
+	res, err := s.ListTags(ctx, &blogpb.ListTagsRequest{})
+	if err != nil {
+		t.Fatalf("ListTags: %v", err)
+	}
+	want := []*blogpb.TagCount{{Tag: "go", Count: 2}, {Tag: "css", Count: 1}, {Tag: "grpc", Count: 1}}
+	if len(res.GetTags()) != len(want) {
+		t.Fatalf("ListTags returned %v, want %v", res.GetTags(), want)
+	}
+	for i, tag := range res.GetTags() {
+		if tag.GetTag() != want[i].GetTag() || tag.GetCount() != want[i].GetCount() {
+			t.Fatalf("ListTags returned %v, want %v", res.GetTags(), want)
+		}
+	}
+
+	tests := []struct {
+		name string
+		req  *blogpb.ListBlogsRequest
+		want int
+	}{
+		{"all the tags", &blogpb.ListBlogsRequest{Tags: []string{"GO", "grpc"}}, 1},
+		{"any of the tags", &blogpb.ListBlogsRequest{Tags: []string{"grpc", "css"}, TagMatch: blogpb.TagMatch_TAG_MATCH_ANY}, 2},
+		{"category", &blogpb.ListBlogsRequest{Category: "Backend"}, 2},
+		{"category and tag", &blogpb.ListBlogsRequest{Category: "Frontend", Tags: []string{"go"}}, 0},
+	}
+	for _, test := range tests {
+		stream := &listBlogsStream{ctx: ctx}
+		err := s.ListBlogs(test.req, stream)
+		if err != nil {
+			t.Fatalf("%v: ListBlogs: %v", test.name, err)
+		}
+		if len(stream.responses) != test.want {
+			t.Errorf("%v: ListBlogs returned %v blogs, want %v", test.name, len(stream.responses), test.want)
+		}
+	}
+
+	_, err = s.CreateBlog(ctx, &blogpb.CreateBlogRequest{Blog: &blogpb.Blog{AuthorId: authorID, Title: "d", Tags: []string{" "}}})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("CreateBlog with an empty tag returned %v, want INVALID_ARGUMENT", err)
+	}
+}
@@ -0,0 +1,260 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// The server can host the blogs of several tenants, each one with its own storage, search index,
+// watchers, caches and attachments. The requests are authenticated by an api key in the metadata,
+// like "authorization: Bearer <api key>", and the key chooses the tenant. The handlers only receive
+// the tenant of the request, so there is no way to reach the data of another tenant.
+// Without the -tenants-file there is only the default tenant, and the requests don't need an api key.
+
+const (
+	defaultTenantID     = "default"
+	authorizationHeader = "authorization"
+	bearerPrefix        = "Bearer "
+)
+
+var tenantIDRegexp = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,62}$`)
+
+// tenantConfig is a tenant of the -tenants-file, the quotas that are not filled use the flag values
+type tenantConfig struct {
+	ID              string   `json:"id"`
+	APIKeys         []string `json:"api_keys"`
+	MaxBlogs        int64    `json:"max_blogs"`
+	MaxContentBytes int64    `json:"max_content_bytes"`
+}
+
+type tenant struct {
+	id      string
+	apiKeys []string
+
+	//the quotas count all the blogs of the tenant, including the trash, 0 is unlimited
+	maxBlogs        int64
+	maxContentBytes int64
+	quotaMu         sync.Mutex //the writes that check the quota are serialized, so two of them can't pass it together
+
+	store       BlogStore
+	cache       *cachedStore //the same store with its cache, nil when the cache is disabled
+	attachments *attachmentStore
+	watcher     *watchHub
+	index       *searchIndex
+	renders     *renderCache
+}
+
+type tenantContextKey struct{}
+
+// loadTenants reads the tenants of the json file, like:
+//
+//	{"tenants": [{"id": "team-a", "api_keys": ["secret"], "max_blogs": 1000, "max_content_bytes": 10485760}]}
+func loadTenants(path string) ([]tenantConfig, error) {
+
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var file struct {
+		Tenants []tenantConfig `json:"tenants"`
+	}
+	err = json.Unmarshal(b, &file)
+	if err != nil {
+		return nil, fmt.Errorf("invalid tenants file: %w", err)
+	}
+	if len(file.Tenants) == 0 {
+		return nil, fmt.Errorf("the tenants file has no tenants")
+	}
+
+	ids := make(map[string]bool)
+	keys := make(map[string]bool)
+	for _, config := range file.Tenants {
+		if !tenantIDRegexp.MatchString(config.ID) {
+			return nil, fmt.Errorf("the tenant id %q is invalid, it must have up to 63 lower case letters, digits, - or _", config.ID)
+		}
+		if ids[config.ID] {
+			return nil, fmt.Errorf("the tenant %v is repeated", config.ID)
+		}
+		ids[config.ID] = true
+
+		if len(config.APIKeys) == 0 {
+			return nil, fmt.Errorf("the tenant %v has no api_keys", config.ID)
+		}
+		for _, key := range config.APIKeys {
+			if key == "" || keys[key] {
+				return nil, fmt.Errorf("the tenant %v has an empty or repeated api key", config.ID)
+			}
+			keys[key] = true
+		}
+		if config.MaxBlogs < 0 || config.MaxContentBytes < 0 {
+			return nil, fmt.Errorf("the tenant %v has a negative quota", config.ID)
+		}
+	}
+
+	return file.Tenants, nil
+}
+
+// newTenant opens the storage and the attachments of the tenant and loads its search index
+func newTenant(ctx context.Context, config tenantConfig) (*tenant, error) {
+
+	connectCtx, cancel := context.WithTimeout(ctx, *connectTimeout)
+	store, err := newStore(connectCtx, *storage, config.ID)
+	cancel()
+	if err != nil {
+		return nil, err
+	}
+	err = startMigrations(ctx, store, config.ID)
+	if err != nil {
+		store.Close(ctx)
+		return nil, err
+	}
+	attachments, err := newAttachmentStore(tenantPath(*attachmentsDir, config.ID), *maxAttachmentSize)
+	if err != nil {
+		store.Close(ctx)
+		return nil, err
+	}
+
+	t := &tenant{
+		id:              config.ID,
+		apiKeys:         config.APIKeys,
+		maxBlogs:        config.MaxBlogs,
+		maxContentBytes: config.MaxContentBytes,
+		store:           store,
+		attachments:     attachments,
+		watcher:         newWatchHub(),
+		index:           newSearchIndex(),
+		renders:         newRenderCache(),
+	}
+	if *cacheSize > 0 {
+		t.cache = newCachedStore(store, *cacheSize)
+		t.store = t.cache
+	}
+	if t.maxBlogs == 0 {
+		t.maxBlogs = *maxBlogs
+	}
+	if t.maxContentBytes == 0 {
+		t.maxContentBytes = *maxContentBytes
+	}
+
+	err = t.index.load(ctx, store)
+	if err != nil {
+		store.Close(ctx)
+		return nil, err
+	}
+
+	return t, nil
+}
+
+// tenantPath returns where the files of the tenant are saved, the default tenant uses the path as it is,
+// so the data saved before the tenants existed is kept. The others use a directory of their own, like
+// blog/filedata/blogs.log -> blog/filedata/tenants/team-a/blogs.log
+func tenantPath(path, tenantID string) string {
+	if tenantID == defaultTenantID {
+		return path
+	}
+	return filepath.Join(filepath.Dir(path), "tenants", tenantID, filepath.Base(path))
+}
+
+// tenantDatabase returns the mongo database of the tenant, following the same idea of tenantPath
+func tenantDatabase(database, tenantID string) string {
+	if tenantID == defaultTenantID {
+		return database
+	}
+	return database + "_" + tenantID
+}
+
+// checkQuota returns a RESOURCE_EXHAUSTED error if the tenant can't have more blogs or content bytes,
+// t.quotaMu must be held until the change is saved
+func (t *tenant) checkQuota(ctx context.Context, blogs, contentBytes int64) error {
+
+	if (t.maxBlogs <= 0 || blogs <= 0) && (t.maxContentBytes <= 0 || contentBytes <= 0) {
+		return nil
+	}
+
+	usage, err := t.store.Usage(ctx)
+	if err != nil {
+		return storeError(err, "")
+	}
+	if t.maxBlogs > 0 && blogs > 0 && usage.Blogs+blogs > t.maxBlogs {
+		return status.Errorf(codes.ResourceExhausted, "The tenant %v has reached its quota of %v blogs, delete some from the trash to create new ones", t.id, t.maxBlogs)
+	}
+	if t.maxContentBytes > 0 && contentBytes > 0 && usage.ContentBytes+contentBytes > t.maxContentBytes {
+		return status.Errorf(codes.ResourceExhausted, "The tenant %v has %v of its %v content bytes used, the blog needs %v more", t.id, usage.ContentBytes, t.maxContentBytes, contentBytes)
+	}
+
+	return nil
+}
+
+// tenantFromContext returns the tenant of the request, the auth interceptors put it in the context of all the BlogService requests
+func tenantFromContext(ctx context.Context) *tenant {
+	t, _ := ctx.Value(tenantContextKey{}).(*tenant)
+	return t
+}
+
+// authenticate returns the context with the tenant of the api key sent in the metadata
+func (s *server) authenticate(ctx context.Context) (context.Context, error) {
+
+	if len(s.apiKeys) == 0 {
+		return context.WithValue(ctx, tenantContextKey{}, s.tenants[defaultTenantID]), nil
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get(authorizationHeader)
+	if len(values) != 1 || !strings.HasPrefix(values[0], bearerPrefix) {
+		return nil, status.Errorf(codes.Unauthenticated, "The %v metadata is required, like: %v<api key>", authorizationHeader, bearerPrefix)
+	}
+	t, ok := s.apiKeys[strings.TrimPrefix(values[0], bearerPrefix)]
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "The api key is invalid")
+	}
+
+	return context.WithValue(ctx, tenantContextKey{}, t), nil
+}
+
+func (s *server) unaryAuthInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if !isBlogServiceMethod(info.FullMethod) {
+		return handler(ctx, req)
+	}
+	ctx, err := s.authenticate(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+func (s *server) streamAuthInterceptor(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if !isBlogServiceMethod(info.FullMethod) {
+		return handler(srv, stream)
+	}
+	ctx, err := s.authenticate(stream.Context())
+	if err != nil {
+		return err
+	}
+	return handler(srv, &tenantStream{ServerStream: stream, ctx: ctx})
+}
+
+// tenantStream is a stream with the tenant in its context
+type tenantStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *tenantStream) Context() context.Context {
+	return s.ctx
+}
+
+// isBlogServiceMethod checks if the method is of the BlogService, the others, like the reflection, don't need an api key
+func isBlogServiceMethod(fullMethod string) bool {
+	return strings.HasPrefix(fullMethod, "/blog.BlogService/")
+}
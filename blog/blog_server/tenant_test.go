@@ -0,0 +1,74 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/diegoclair/grpc-go-course/blog/blogpb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func withAPIKey(key string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(authorizationHeader, bearerPrefix+key))
+}
+
+func TestTenantIsolation(t *testing.T) {
+	s := newServer([]*tenant{newTestTenant(t, "team-a", "key-a"), newTestTenant(t, "team-b", "key-b")}, *trashRetention)
+
+	for _, ctx := range []context.Context{context.Background(), withAPIKey("unknown")} {
+		_, err := s.authenticate(ctx)
+		if status.Code(err) != codes.Unauthenticated {
+			t.Errorf("authenticate without a valid api key returned %v, want UNAUTHENTICATED", err)
+		}
+	}
+
+	ctxA, err := s.authenticate(withAPIKey("key-a"))
+	if err != nil {
+		t.Fatalf("authenticate: %v", err)
+	}
+	ctxB, err := s.authenticate(withAPIKey("key-b"))
+	if err != nil {
+		t.Fatalf("authenticate: %v", err)
+	}
+	if tenantFromContext(ctxA).id != "team-a" || tenantFromContext(ctxB).id != "team-b" {
+		t.Fatalf("the api keys should choose their tenants, got %v and %v", tenantFromContext(ctxA).id, tenantFromContext(ctxB).id)
+	}
+
+	authorID := createTestAuthor(t, s, ctxA)
+	blog := createTestBlog(t, s, ctxA, authorID, "of the team a")
+	_, err = s.ReadBlog(ctxB, &blogpb.ReadBlogRequest{BlogId: blog.GetId()})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("ReadBlog of the blog of another tenant returned %v, want NOT_FOUND", err)
+	}
+	_, err = s.CreateBlog(ctxB, &blogpb.CreateBlogRequest{Blog: &blogpb.Blog{AuthorId: authorID, Title: "title"}})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("CreateBlog with the author of another tenant returned %v, want FAILED_PRECONDITION", err)
+	}
+}
+
+func TestTenantQuota(t *testing.T) {
+	s, ctx := newTestServer(t)
+	tn := tenantFromContext(ctx)
+	tn.maxBlogs = 2
+	tn.maxContentBytes = 100
+	authorID := createTestAuthor(t, s, ctx)
+
+	_, err := s.CreateBlog(ctx, &blogpb.CreateBlogRequest{Blog: &blogpb.Blog{AuthorId: authorID, Title: "too long", Content: string(make([]byte, 101))}})
+	if status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("CreateBlog over the content quota returned %v, want RESOURCE_EXHAUSTED", err)
+	}
+
+	//the blogs in the trash still count
+	blog := createTestBlog(t, s, ctx, authorID, "first")
+	createTestBlog(t, s, ctx, authorID, "second")
+	_, err = s.DeleteBlog(ctx, &blogpb.DeleteBlogRequest{BlogId: blog.GetId()})
+	if err != nil {
+		t.Fatalf("DeleteBlog: %v", err)
+	}
+	_, err = s.CreateBlog(ctx, &blogpb.CreateBlogRequest{Blog: &blogpb.Blog{AuthorId: authorID, Title: "third"}})
+	if status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("CreateBlog over the blogs quota returned %v, want RESOURCE_EXHAUSTED", err)
+	}
+}
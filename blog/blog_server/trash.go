@@ -0,0 +1,103 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/diegoclair/grpc-go-course/blog/blogpb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// The deleted blogs are kept in the trash for the -trash-retention period, while they are there
+// they can be restored. A background loop purges the expired ones every -purge-interval.
+
+func (s *server) ListDeletedBlogs(req *blogpb.ListDeletedBlogsRequest, stream blogpb.BlogService_ListDeletedBlogsServer) error {
+	fmt.Printf("ListDeletedBlogs function was invoked with %v\n", req)
+	t := tenantFromContext(stream.Context())
+
+	pageSize, afterID, err := parsePage(req.GetPageSize(), req.GetCursor())
+	if err != nil {
+		return err
+	}
+	mask, err := parseBlogMask(req.GetReadMask(), "read_mask")
+	if err != nil {
+		return err
+	}
+
+	filter := blogFilter{
+		AuthorID: req.GetAuthorId(),
+		AfterID:  afterID,
+		Limit:    pageSize,
+		Deleted:  true,
+	}
+	err = t.store.ListBlogs(stream.Context(), filter, func(blog *blogpb.Blog) error {
+		return stream.Send(&blogpb.ListDeletedBlogsResponse{
+			Blog:    mask.read(blog),
+			PurgeAt: timestamppb.New(blog.GetDeletedAt().AsTime().Add(s.trashRetention)),
+			Cursor:  encodeCursor(blog.GetId()),
+		})
+	})
+	if err != nil {
+		return storeError(err, "")
+	}
+
+	return nil
+}
+
+func (s *server) RestoreBlog(ctx context.Context, req *blogpb.RestoreBlogRequest) (*blogpb.RestoreBlogResponse, error) {
+	fmt.Printf("RestoreBlog function was invoked with %v\n", req)
+	t := tenantFromContext(ctx)
+
+	blogID := req.GetBlogId()
+	if blogID == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "The blog_id is required")
+	}
+
+	restored, err := t.store.RestoreBlog(ctx, blogID)
+	if err != nil {
+		return nil, storeError(err, blogID)
+	}
+	t.blogChanged(blogpb.BlogEventType_BLOG_EVENT_TYPE_RESTORED, restored)
+
+	return &blogpb.RestoreBlogResponse{
+		Blog: restored,
+	}, nil
+}
+
+// purgeLoop removes the expired blogs from the trash until the context is canceled
+func (s *server) purgeLoop(ctx context.Context, interval time.Duration) {
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			for _, t := range s.tenants {
+				t.purge(ctx, time.Now().Add(-s.trashRetention))
+			}
+		}
+	}
+}
+
+// purge removes the blogs moved to the trash before deletedBefore
+func (t *tenant) purge(ctx context.Context, deletedBefore time.Time) {
+
+	//the blogs in the trash are not in the index anymore, so there is nothing else to update
+	purged, err := t.store.PurgeBlogs(ctx, deletedBefore)
+	for _, blog := range purged {
+		t.deleteAttachments(blog.GetId())
+	}
+	if len(purged) > 0 {
+		fmt.Printf("Purged %v blogs from the trash of the tenant %v\n", len(purged), t.id)
+	}
+	if err != nil && ctx.Err() == nil {
+		log.Printf("Error while purging the trash of the tenant %v: %v", t.id, err)
+	}
+}
@@ -0,0 +1,189 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/diegoclair/grpc-go-course/blog/blogpb"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// The Blog and Author fields are validated by the rules below before the handlers run, wherever they are in the
+// request, like in CreateBlogRequest.blog or in the operations of a batch. All the invalid fields are returned
+// together in a BadRequest detail, so the clients can show them next to the fields of a form.
+// The rules only check the values sent by the client, the handlers still check what depends on the stored
+// data, like if the author exists.
+
+const (
+	maxIDLength      = 64
+	maxTitleLength   = 300
+	maxContentLength = 1 << 20
+)
+
+var idRegexp = regexp.MustCompile(`^[A-Za-z0-9_-]*$`)
+
+// idRule is the rule of the ids sent by the clients, an empty id is filled by the server
+var idRule = fieldRule{maxLength: maxIDLength, pattern: idRegexp, allowed: "letters, digits, - and _"}
+
+// fieldRule is the validation of a string field, each value of a repeated field is checked alone.
+// A value with only spaces is empty for the required, the length and the pattern check the value as it is saved.
+type fieldRule struct {
+	required  bool
+	maxLength int            // in bytes, 0 is unlimited
+	pattern   *regexp.Regexp // when set the value must match it
+	allowed   string         // the characters of the pattern, used in the error messages
+}
+
+// blogRules are the rules of the Blog fields sent by the clients, the others are filled by the server
+var blogRules = map[protoreflect.Name]fieldRule{
+	"id":        idRule,
+	"author_id": {required: true, maxLength: maxIDLength, pattern: idRegexp, allowed: "letters, digits, - and _"},
+	"title":     {required: true, maxLength: maxTitleLength},
+	"content":   {maxLength: maxContentLength},
+	"tags":      {maxLength: maxTagLength},
+	"category":  {maxLength: maxCategoryLength},
+}
+
+// authorRules are the rules of the Author fields, the blogs reference the author id so it follows the same rule
+var authorRules = map[protoreflect.Name]fieldRule{
+	"id": idRule,
+}
+
+// validationInterceptor rejects the unary requests that have invalid blogs
+func validationInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if msg, ok := req.(proto.Message); ok {
+		err := validateRequest(msg)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return handler(ctx, req)
+}
+
+// validateRequest returns an INVALID_ARGUMENT error with a BadRequest detail if any blog of the request is invalid
+func validateRequest(req proto.Message) error {
+
+	violations := make([]*errdetails.BadRequest_FieldViolation, 0)
+	collectViolations(req.ProtoReflect(), "", &violations)
+	if len(violations) == 0 {
+		return nil
+	}
+
+	fields := make([]string, 0, len(violations))
+	for _, v := range violations {
+		fields = append(fields, fmt.Sprintf("%v: %v", v.GetField(), v.GetDescription()))
+	}
+	st := status.Newf(codes.InvalidArgument, "Invalid request: %v", strings.Join(fields, "; "))
+	detailed, err := st.WithDetails(&errdetails.BadRequest{FieldViolations: violations})
+	if err != nil {
+		return st.Err()
+	}
+
+	return detailed.Err()
+}
+
+// collectViolations looks for the blogs and authors in the message and its fields, path is where the message is in the request
+func collectViolations(m protoreflect.Message, path string, violations *[]*errdetails.BadRequest_FieldViolation) {
+
+	switch msg := m.Interface().(type) {
+	case *blogpb.Blog:
+		blogViolations(msg, path, false, nil, violations)
+		return
+	case *blogpb.UpdateBlogRequest:
+		//with an update mask, the fields out of it are not changed, so they are not validated
+		blogViolations(msg.GetBlog(), fieldPath(path, "blog"), true, msg.GetUpdateMask().GetPaths(), violations)
+		return
+	case *blogpb.Author:
+		ruleViolations(m, authorRules, path, nil, violations)
+		return
+	}
+
+	m.Range(func(field protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		if field.Kind() != protoreflect.MessageKind || field.IsMap() {
+			return true
+		}
+		name := fieldPath(path, string(field.Name()))
+		if field.IsList() {
+			for i := 0; i < value.List().Len(); i++ {
+				collectViolations(value.List().Get(i).Message(), fmt.Sprintf("%v[%v]", name, i), violations)
+			}
+			return true
+		}
+		collectViolations(value.Message(), name, violations)
+		return true
+	})
+}
+
+// blogViolations checks the fields of the blog against the blogRules, an update also needs the blog id
+func blogViolations(blog *blogpb.Blog, path string, update bool, mask []string, violations *[]*errdetails.BadRequest_FieldViolation) {
+
+	if update && blog.GetId() == "" {
+		*violations = append(*violations, &errdetails.BadRequest_FieldViolation{
+			Field:       fieldPath(path, "id"),
+			Description: "The id is required",
+		})
+	}
+
+	ruleViolations(blog.ProtoReflect(), blogRules, path, mask, violations)
+}
+
+// ruleViolations checks the fields of the message against the rules, when there is a mask only its fields are checked
+func ruleViolations(m protoreflect.Message, rules map[protoreflect.Name]fieldRule, path string, mask []string, violations *[]*errdetails.BadRequest_FieldViolation) {
+
+	masked := make(map[string]bool)
+	for _, p := range mask {
+		masked[p] = true
+	}
+
+	fields := m.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		rule, ok := rules[field.Name()]
+		if !ok || (len(mask) > 0 && !masked[string(field.Name())]) {
+			continue
+		}
+
+		name := fieldPath(path, string(field.Name()))
+		if !field.IsList() {
+			if description := rule.check(string(field.Name()), m.Get(field).String()); description != "" {
+				*violations = append(*violations, &errdetails.BadRequest_FieldViolation{Field: name, Description: description})
+			}
+			continue
+		}
+		list := m.Get(field).List()
+		for j := 0; j < list.Len(); j++ {
+			if description := rule.check(string(field.Name()), list.Get(j).String()); description != "" {
+				*violations = append(*violations, &errdetails.BadRequest_FieldViolation{Field: fmt.Sprintf("%v[%v]", name, j), Description: description})
+			}
+		}
+	}
+}
+
+// check returns why the value breaks the rule, or an empty string if it is valid
+func (r fieldRule) check(name, value string) string {
+
+	switch {
+	case r.required && strings.TrimSpace(value) == "":
+		return fmt.Sprintf("The %v is required", name)
+	case r.maxLength > 0 && len(value) > r.maxLength:
+		return fmt.Sprintf("The %v can't have more than %v bytes", name, r.maxLength)
+	case r.pattern != nil && !r.pattern.MatchString(value):
+		return fmt.Sprintf("The %v can only have %v", name, r.allowed)
+	}
+
+	return ""
+}
+
+func fieldPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
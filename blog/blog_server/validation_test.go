@@ -0,0 +1,84 @@
+package main
+
+import (
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/diegoclair/grpc-go-course/blog/blogpb"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// violatedFields returns the sorted fields of the BadRequest detail of the error
+func violatedFields(t *testing.T, err error) []string {
+	t.Helper()
+
+	st := status.Convert(err)
+	if err != nil && st.Code() != codes.InvalidArgument {
+		t.Fatalf("validateRequest returned %v, want INVALID_ARGUMENT", err)
+	}
+	fields := make([]string, 0)
+	for _, detail := range st.Details() {
+		if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+			for _, v := range badRequest.GetFieldViolations() {
+				fields = append(fields, v.GetField())
+			}
+		}
+	}
+	if err != nil && len(fields) == 0 {
+		t.Fatalf("the error %v has no BadRequest detail", err)
+	}
+	sort.Strings(fields)
+	return fields
+}
+
+func TestValidateRequest(t *testing.T) {
+	tests := []struct {
+		name string
+		req  proto.Message
+		want []string
+	}{
+		{"valid blog", &blogpb.CreateBlogRequest{Blog: &blogpb.Blog{Id: "my-blog_1", AuthorId: "author", Title: "title", Tags: []string{"go"}}}, nil},
+		{"all the invalid fields", &blogpb.CreateBlogRequest{Blog: &blogpb.Blog{
+			Id:       "my blog",
+			AuthorId: "author/1",
+			Title:    " ",
+			Content:  strings.Repeat("x", maxContentLength+1),
+			Tags:     []string{"go", strings.Repeat("x", maxTagLength+1)},
+		}}, []string{"blog.author_id", "blog.content", "blog.id", "blog.tags[1]", "blog.title"}},
+		{"spaces around the values", &blogpb.CreateBlogRequest{Blog: &blogpb.Blog{
+			Id:       " my-blog ",
+			AuthorId: "author",
+			Title:    " " + strings.Repeat("x", maxTitleLength) + " ",
+		}}, []string{"blog.id", "blog.title"}},
+		{"update without id", &blogpb.UpdateBlogRequest{Blog: &blogpb.Blog{AuthorId: "author", Title: "title"}}, []string{"blog.id"}},
+		{"update mask without the invalid fields", &blogpb.UpdateBlogRequest{
+			Blog:       &blogpb.Blog{Id: "a", Title: "title", Category: strings.Repeat("x", maxCategoryLength+1)},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"title"}},
+		}, nil},
+		{"update mask with an invalid field", &blogpb.UpdateBlogRequest{
+			Blog:       &blogpb.Blog{Id: "a", Title: "title"},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"title", "author_id"}},
+		}, []string{"blog.author_id"}},
+		{"operations of a batch", &blogpb.BatchWriteBlogsRequest{Operations: []*blogpb.BlogWriteOperation{
+			createOperation("author", "valid"),
+			createOperation("author", ""),
+			updateOperation(&blogpb.Blog{AuthorId: "author", Title: "title"}, 1),
+		}}, []string{"operations[1].create.blog.title", "operations[2].update.blog.id"}},
+		{"request without blogs", &blogpb.ReadBlogRequest{BlogId: "a"}, nil},
+		{"valid author", &blogpb.CreateAuthorRequest{Author: &blogpb.Author{Id: "diego_1", Name: "Diego"}}, nil},
+		{"author with an invalid id", &blogpb.CreateAuthorRequest{Author: &blogpb.Author{Id: "diego/1", Name: "Diego"}}, []string{"author.id"}},
+		{"imported author", &blogpb.ImportBlogsRequest{Record: &blogpb.ImportBlogsRequest_Author{Author: &blogpb.Author{Id: strings.Repeat("x", maxIDLength+1)}}}, []string{"author.id"}},
+	}
+
+	for _, test := range tests {
+		got := violatedFields(t, validateRequest(test.req))
+		if strings.Join(got, ",") != strings.Join(test.want, ",") {
+			t.Errorf("%v: the violated fields are %v, want %v", test.name, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,174 @@
+package main
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/diegoclair/grpc-go-course/blog/blogpb"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	watchHistorySize    = 1000 //how many events we keep to resume the watchers
+	watchSubscriberSize = 100  //how many events a watcher can have waiting to be sent before it is dropped
+	watchErrorReason    = "WATCHER_TOO_SLOW"
+)
+
+var (
+	errResumeTokenMalformed = errors.New("the resume token is malformed")
+	errResumeTokenExpired   = errors.New("the resume token is too old")
+)
+
+// watchHub receives the blog changes from the handlers and sends them to the WatchBlogs subscribers.
+// The writers never wait for the subscribers: if a subscriber buffer is full, it is dropped and
+// the client has to reconnect with its last resume token.
+type watchHub struct {
+	mu          sync.Mutex
+	epoch       string //identifies this server run, a token from another run can't be resumed
+	seq         uint64
+	history     []*blogpb.WatchBlogsResponse //last events, from the oldest to the newest
+	subscribers map[*watchSubscriber]struct{}
+}
+
+type watchSubscriber struct {
+	events  chan *blogpb.WatchBlogsResponse
+	dropped chan struct{} //closed when the subscriber is too slow and was removed
+}
+
+func newWatchHub() *watchHub {
+	return &watchHub{
+		epoch:       strconv.FormatInt(time.Now().UnixNano(), 36),
+		subscribers: make(map[*watchSubscriber]struct{}),
+	}
+}
+
+// publish sends the event to all the subscribers, it never blocks
+func (h *watchHub) publish(eventType blogpb.BlogEventType, blog *blogpb.Blog) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.seq++
+	event := &blogpb.WatchBlogsResponse{
+		Type:        eventType,
+		Blog:        proto.Clone(blog).(*blogpb.Blog),
+		EventTime:   timestamppb.Now(),
+		ResumeToken: h.encodeToken(h.seq),
+	}
+
+	h.history = append(h.history, event)
+	if len(h.history) > watchHistorySize {
+		h.history = h.history[len(h.history)-watchHistorySize:]
+	}
+
+	for sub := range h.subscribers {
+		select {
+		case sub.events <- event:
+		default:
+			close(sub.dropped)
+			delete(h.subscribers, sub)
+		}
+	}
+}
+
+// subscribe registers a new subscriber, if the resumeToken is filled the events after it are sent first
+func (h *watchHub) subscribe(resumeToken string) (*watchSubscriber, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	backlog, err := h.eventsAfter(resumeToken)
+	if err != nil {
+		return nil, err
+	}
+
+	sub := &watchSubscriber{
+		events:  make(chan *blogpb.WatchBlogsResponse, len(backlog)+watchSubscriberSize),
+		dropped: make(chan struct{}),
+	}
+	for _, event := range backlog {
+		sub.events <- event
+	}
+	h.subscribers[sub] = struct{}{}
+
+	return sub, nil
+}
+
+func (h *watchHub) unsubscribe(sub *watchSubscriber) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	delete(h.subscribers, sub)
+}
+
+// eventsAfter returns the events that happened after the token, h.mu must be held
+func (h *watchHub) eventsAfter(resumeToken string) ([]*blogpb.WatchBlogsResponse, error) {
+	if resumeToken == "" {
+		return nil, nil
+	}
+
+	epoch, seq, err := decodeToken(resumeToken)
+	if err != nil {
+		return nil, err
+	}
+	if epoch != h.epoch || seq > h.seq {
+		return nil, errResumeTokenExpired
+	}
+
+	//the history has the events from h.seq-len(h.history)+1 to h.seq
+	missing := int(h.seq - seq)
+	if missing > len(h.history) {
+		return nil, errResumeTokenExpired
+	}
+
+	return h.history[len(h.history)-missing:], nil
+}
+
+func (h *watchHub) encodeToken(seq uint64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf("%v:%v", h.epoch, seq)))
+}
+
+func decodeToken(token string) (epoch string, seq uint64, err error) {
+
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return "", 0, errResumeTokenMalformed
+	}
+
+	parts := strings.SplitN(string(b), ":", 2)
+	if len(parts) != 2 {
+		return "", 0, errResumeTokenMalformed
+	}
+
+	seq, err = strconv.ParseUint(parts[1], 10, 64)
+	if err != nil {
+		return "", 0, errResumeTokenMalformed
+	}
+
+	return parts[0], seq, nil
+}
+
+// watcherTooSlow returns the RESOURCE_EXHAUSTED error of a dropped watcher, the ErrorInfo detail has the token
+// of the last event it received, so the client can resume even if it didn't keep it
+func watcherTooSlow(lastToken string) error {
+
+	st := status.Newf(codes.ResourceExhausted, "The watcher is too slow, reconnect with the resume_token %q", lastToken)
+	detailed, err := st.WithDetails(&errdetails.ErrorInfo{
+		Reason: watchErrorReason,
+		Domain: "blog.BlogService",
+		Metadata: map[string]string{
+			"resume_token": lastToken,
+		},
+	})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
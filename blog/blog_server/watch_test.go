@@ -0,0 +1,123 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/diegoclair/grpc-go-course/blog/blogpb"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// watchBlogsStream keeps the events sent by WatchBlogs, the first Send waits for release
+type watchBlogsStream struct {
+	grpc.ServerStream
+	ctx     context.Context
+	sending chan struct{} //closed when the first Send starts
+	release chan struct{}
+	events  []*blogpb.WatchBlogsResponse
+}
+
+func (s *watchBlogsStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *watchBlogsStream) Send(res *blogpb.WatchBlogsResponse) error {
+	if len(s.events) == 0 {
+		close(s.sending)
+		<-s.release
+	}
+	s.events = append(s.events, res)
+	return nil
+}
+
+func TestWatchBlogsSlowWatcher(t *testing.T) {
+	s, ctx := newTestServer(t)
+	hub := tenantFromContext(ctx).watcher
+
+	stream := &watchBlogsStream{ctx: ctx, sending: make(chan struct{}), release: make(chan struct{})}
+	done := make(chan error)
+	go func() {
+		done <- s.WatchBlogs(&blogpb.WatchBlogsRequest{}, stream)
+	}()
+
+	//waits for the watch to subscribe
+	for subscribed := false; !subscribed; time.Sleep(time.Millisecond) {
+		hub.mu.Lock()
+		subscribed = len(hub.subscribers) > 0
+		hub.mu.Unlock()
+	}
+
+	//the first event is being sent while the next ones fill the buffer, the last one drops the watcher
+	hub.publish(blogpb.BlogEventType_BLOG_EVENT_TYPE_CREATED, &blogpb.Blog{Id: "0"})
+	<-stream.sending
+	for i := 1; i <= watchSubscriberSize+1; i++ {
+		hub.publish(blogpb.BlogEventType_BLOG_EVENT_TYPE_CREATED, &blogpb.Blog{Id: fmt.Sprint(i)})
+	}
+	close(stream.release)
+
+	err := <-done
+	if status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("WatchBlogs returned %v, want RESOURCE_EXHAUSTED", err)
+	}
+	if len(stream.events) != watchSubscriberSize+1 {
+		t.Fatalf("the events in the buffer should be sent before the error, %v were sent", len(stream.events))
+	}
+	lastToken := stream.events[len(stream.events)-1].GetResumeToken()
+	var info *errdetails.ErrorInfo
+	for _, detail := range status.Convert(err).Details() {
+		info, _ = detail.(*errdetails.ErrorInfo)
+	}
+	if info.GetReason() != watchErrorReason || info.GetMetadata()["resume_token"] != lastToken {
+		t.Errorf("the error should have the token of the last event sent %v, got %v", lastToken, info)
+	}
+
+	//the client reconnects with the token and receives the event that didn't fit
+	sub, err := hub.subscribe(lastToken)
+	if err != nil {
+		t.Fatalf("subscribe: %v", err)
+	}
+	defer hub.unsubscribe(sub)
+	if len(sub.events) != 1 || (<-sub.events).GetBlog().GetId() != fmt.Sprint(watchSubscriberSize+1) {
+		t.Errorf("the resumed watch should receive only the dropped event")
+	}
+}
+
+func TestWatchBlogsDeleteFromTheTrash(t *testing.T) {
+	s, ctx := newTestServer(t)
+	authorID := createTestAuthor(t, s, ctx)
+	hub := tenantFromContext(ctx).watcher
+	sub, err := hub.subscribe("")
+	if err != nil {
+		t.Fatalf("subscribe: %v", err)
+	}
+	defer hub.unsubscribe(sub)
+
+	trashed := createTestBlog(t, s, ctx, authorID, "trashed first")
+	removed := createTestBlog(t, s, ctx, authorID, "removed")
+	for _, req := range []*blogpb.DeleteBlogRequest{
+		{BlogId: trashed.GetId()},
+		{BlogId: trashed.GetId(), Permanent: true},
+		{BlogId: removed.GetId(), Permanent: true},
+	} {
+		_, err := s.DeleteBlog(ctx, req)
+		if err != nil {
+			t.Fatalf("DeleteBlog: %v", err)
+		}
+	}
+
+	//the blog removed from the trash was already deleted for the watchers
+	want := []string{"CREATED " + trashed.GetId(), "CREATED " + removed.GetId(), "DELETED " + trashed.GetId(), "DELETED " + removed.GetId()}
+	got := make([]string, 0)
+	for len(sub.events) > 0 {
+		event := <-sub.events
+		got = append(got, fmt.Sprintf("%v %v", event.GetType().String()[len("BLOG_EVENT_TYPE_"):], event.GetBlog().GetId()))
+	}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("the watchers received %v, want %v", got, want)
+	}
+}
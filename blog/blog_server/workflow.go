@@ -0,0 +1,266 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/diegoclair/grpc-go-course/blog/blogpb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// maxScheduleWait is the longest time the scheduler sleeps, so it also sees the blogs scheduled by other servers
+const maxScheduleWait = time.Minute
+
+// blogTransitions are the status changes allowed by the workflow, from -> to
+var blogTransitions = map[blogpb.BlogStatus][]blogpb.BlogStatus{
+	blogpb.BlogStatus_BLOG_STATUS_DRAFT:     {blogpb.BlogStatus_BLOG_STATUS_IN_REVIEW},
+	blogpb.BlogStatus_BLOG_STATUS_IN_REVIEW: {blogpb.BlogStatus_BLOG_STATUS_SCHEDULED, blogpb.BlogStatus_BLOG_STATUS_PUBLISHED, blogpb.BlogStatus_BLOG_STATUS_DRAFT},
+	blogpb.BlogStatus_BLOG_STATUS_SCHEDULED: {blogpb.BlogStatus_BLOG_STATUS_SCHEDULED, blogpb.BlogStatus_BLOG_STATUS_PUBLISHED, blogpb.BlogStatus_BLOG_STATUS_DRAFT},
+	blogpb.BlogStatus_BLOG_STATUS_PUBLISHED: {blogpb.BlogStatus_BLOG_STATUS_ARCHIVED, blogpb.BlogStatus_BLOG_STATUS_DRAFT},
+	blogpb.BlogStatus_BLOG_STATUS_ARCHIVED:  {blogpb.BlogStatus_BLOG_STATUS_DRAFT},
+}
+
+func (s *server) SubmitBlogForReview(ctx context.Context, req *blogpb.SubmitBlogForReviewRequest) (*blogpb.SubmitBlogForReviewResponse, error) {
+	fmt.Printf("SubmitBlogForReview function was invoked with %v\n", req)
+	t := tenantFromContext(ctx)
+
+	blog, err := t.changeStatus(ctx, req.GetBlogId(), req.GetExpectedRevision(), blogpb.BlogStatus_BLOG_STATUS_IN_REVIEW, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &blogpb.SubmitBlogForReviewResponse{
+		Blog: blog,
+	}, nil
+}
+
+func (s *server) PublishBlog(ctx context.Context, req *blogpb.PublishBlogRequest) (*blogpb.PublishBlogResponse, error) {
+	fmt.Printf("PublishBlog function was invoked with %v\n", req)
+	t := tenantFromContext(ctx)
+
+	if req.GetPublishAt() != nil {
+		if err := req.GetPublishAt().CheckValid(); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "The publish_at is invalid: %v", err)
+		}
+	}
+
+	target := blogpb.BlogStatus_BLOG_STATUS_PUBLISHED
+	if req.GetPublishAt().AsTime().After(time.Now()) {
+		target = blogpb.BlogStatus_BLOG_STATUS_SCHEDULED
+	}
+
+	blog, err := t.changeStatus(ctx, req.GetBlogId(), req.GetExpectedRevision(), target, req.GetPublishAt())
+	if err != nil {
+		return nil, err
+	}
+	if target == blogpb.BlogStatus_BLOG_STATUS_SCHEDULED {
+		s.wakeScheduler()
+	}
+
+	return &blogpb.PublishBlogResponse{
+		Blog: blog,
+	}, nil
+}
+
+func (s *server) ArchiveBlog(ctx context.Context, req *blogpb.ArchiveBlogRequest) (*blogpb.ArchiveBlogResponse, error) {
+	fmt.Printf("ArchiveBlog function was invoked with %v\n", req)
+	t := tenantFromContext(ctx)
+
+	blog, err := t.changeStatus(ctx, req.GetBlogId(), req.GetExpectedRevision(), blogpb.BlogStatus_BLOG_STATUS_ARCHIVED, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &blogpb.ArchiveBlogResponse{
+		Blog: blog,
+	}, nil
+}
+
+func (s *server) ReturnBlogToDraft(ctx context.Context, req *blogpb.ReturnBlogToDraftRequest) (*blogpb.ReturnBlogToDraftResponse, error) {
+	fmt.Printf("ReturnBlogToDraft function was invoked with %v\n", req)
+	t := tenantFromContext(ctx)
+
+	blog, err := t.changeStatus(ctx, req.GetBlogId(), req.GetExpectedRevision(), blogpb.BlogStatus_BLOG_STATUS_DRAFT, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &blogpb.ReturnBlogToDraftResponse{
+		Blog: blog,
+	}, nil
+}
+
+// changeStatus moves the blog to the target status, saving it as a new revision.
+// When expectedRevision is 0 the current revision is used, so only a concurrent change makes it fail.
+func (t *tenant) changeStatus(ctx context.Context, blogID string, expectedRevision int64, target blogpb.BlogStatus, publishAt *timestamppb.Timestamp) (*blogpb.Blog, error) {
+
+	if blogID == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "The blog_id is required")
+	}
+
+	current, err := t.store.ReadBlog(ctx, blogID)
+	if err != nil {
+		return nil, storeError(err, blogID)
+	}
+	if expectedRevision <= 0 {
+		expectedRevision = current.GetRevision()
+	}
+
+	from := blogStatus(current)
+	if !canChangeStatus(from, target) {
+		return nil, status.Errorf(codes.FailedPrecondition, "The blog with id %v can't change from %v to %v", blogID, from, target)
+	}
+
+	now := timestamppb.Now()
+	data := proto.Clone(current).(*blogpb.Blog)
+	data.Status = target
+	data.UpdatedAt = now
+	switch target {
+	case blogpb.BlogStatus_BLOG_STATUS_SCHEDULED:
+		data.PublishAt = publishAt
+	case blogpb.BlogStatus_BLOG_STATUS_PUBLISHED:
+		data.PublishedAt = now
+	case blogpb.BlogStatus_BLOG_STATUS_DRAFT:
+		data.PublishAt = nil
+		data.PublishedAt = nil
+	}
+
+	data, err = t.store.UpdateBlog(ctx, data, expectedRevision)
+	if err != nil {
+		return nil, storeError(err, blogID)
+	}
+	t.blogChanged(blogpb.BlogEventType_BLOG_EVENT_TYPE_UPDATED, data)
+
+	return data, nil
+}
+
+// setInitialStatus sets the status of a new blog, it starts as a draft.
+// The imported blogs keep their status, without one they are published.
+func setInitialStatus(blog *blogpb.Blog, imported bool) {
+	if imported && blog.GetStatus() != blogpb.BlogStatus_BLOG_STATUS_UNSPECIFIED {
+		return
+	}
+	if imported {
+		blog.Status = blogpb.BlogStatus_BLOG_STATUS_PUBLISHED
+		if blog.GetPublishedAt() == nil {
+			blog.PublishedAt = blog.GetCreatedAt()
+		}
+		return
+	}
+
+	blog.Status = blogpb.BlogStatus_BLOG_STATUS_DRAFT
+	blog.PublishAt = nil
+	blog.PublishedAt = nil
+}
+
+// blogStatus returns the status of the blog, the blogs saved before the workflow existed are published
+func blogStatus(blog *blogpb.Blog) blogpb.BlogStatus {
+	if blog.GetStatus() == blogpb.BlogStatus_BLOG_STATUS_UNSPECIFIED {
+		return blogpb.BlogStatus_BLOG_STATUS_PUBLISHED
+	}
+	return blog.GetStatus()
+}
+
+func canChangeStatus(from, to blogpb.BlogStatus) bool {
+	for _, allowed := range blogTransitions[from] {
+		if allowed == to {
+			return true
+		}
+	}
+	return false
+}
+
+// wakeScheduler makes the scheduler look for the scheduled blogs again, it never blocks
+func (s *server) wakeScheduler() {
+	select {
+	case s.scheduleWake <- struct{}{}:
+	default:
+		//there is already a wake up pending
+	}
+}
+
+// scheduleLoop publishes the scheduled blogs when their publish_at arrives, until the context is canceled.
+// It sleeps until the next publish_at, or until a blog is scheduled.
+func (s *server) scheduleLoop(ctx context.Context) {
+
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.scheduleWake:
+		case <-timer.C:
+		}
+
+		var next time.Time
+		for _, t := range s.tenants {
+			tenantNext := t.publishScheduled(ctx)
+			if !tenantNext.IsZero() && (next.IsZero() || tenantNext.Before(next)) {
+				next = tenantNext
+			}
+		}
+
+		wait := maxScheduleWait
+		if !next.IsZero() && time.Until(next) < wait {
+			wait = time.Until(next)
+		}
+		if !timer.Stop() {
+			select {
+			case <-timer.C:
+			default:
+			}
+		}
+		timer.Reset(wait)
+	}
+}
+
+// publishScheduled publishes the scheduled blogs that are due and returns the next publish_at, zero if there is none
+func (t *tenant) publishScheduled(ctx context.Context) time.Time {
+
+	due := make([]*blogpb.Blog, 0)
+	var next time.Time
+	now := time.Now()
+	err := t.store.ListBlogs(ctx, blogFilter{Status: blogpb.BlogStatus_BLOG_STATUS_SCHEDULED}, func(blog *blogpb.Blog) error {
+		publishAt := blog.GetPublishAt().AsTime()
+		if !publishAt.After(now) {
+			due = append(due, blog)
+		} else if next.IsZero() || publishAt.Before(next) {
+			next = publishAt
+		}
+		return nil
+	})
+	if err != nil {
+		if ctx.Err() == nil {
+			log.Printf("Error while listing the scheduled blogs of the tenant %v: %v", t.id, err)
+		}
+		return time.Time{}
+	}
+
+	//we send the revision that we have read, so a blog changed in the meantime is not published
+	for _, blog := range due {
+		_, err := t.changeStatus(ctx, blog.GetId(), blog.GetRevision(), blogpb.BlogStatus_BLOG_STATUS_PUBLISHED, nil)
+		if err != nil {
+			if ctx.Err() != nil {
+				return time.Time{}
+			}
+			log.Printf("Error while publishing the scheduled blog %v: %v", blog.GetId(), err)
+
+			//we try again soon, if it was changed to another status it won't be listed anymore
+			retry := time.Now().Add(time.Second)
+			if next.IsZero() || retry.Before(next) {
+				next = retry
+			}
+			continue
+		}
+		fmt.Printf("Scheduled blog %v was published\n", blog.GetId())
+	}
+
+	return next
+}
@@ -0,0 +1,97 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/diegoclair/grpc-go-course/blog/blogpb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestBlogWorkflow(t *testing.T) {
+	s, ctx := newTestServer(t)
+	authorID := createTestAuthor(t, s, ctx)
+	blog := createTestBlog(t, s, ctx, authorID, "workflow")
+
+	//a draft must be reviewed before it is published
+	_, err := s.PublishBlog(ctx, &blogpb.PublishBlogRequest{BlogId: blog.GetId()})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("PublishBlog of a draft returned %v, want FAILED_PRECONDITION", err)
+	}
+
+	review, err := s.SubmitBlogForReview(ctx, &blogpb.SubmitBlogForReviewRequest{BlogId: blog.GetId(), ExpectedRevision: blog.GetRevision()})
+	if err != nil || review.GetBlog().GetStatus() != blogpb.BlogStatus_BLOG_STATUS_IN_REVIEW {
+		t.Fatalf("SubmitBlogForReview returned %v (%v)", review, err)
+	}
+	_, err = s.PublishBlog(ctx, &blogpb.PublishBlogRequest{BlogId: blog.GetId(), ExpectedRevision: blog.GetRevision()})
+	if status.Code(err) != codes.Aborted {
+		t.Errorf("PublishBlog with an old revision returned %v, want ABORTED", err)
+	}
+	published, err := s.PublishBlog(ctx, &blogpb.PublishBlogRequest{BlogId: blog.GetId()})
+	if err != nil || published.GetBlog().GetStatus() != blogpb.BlogStatus_BLOG_STATUS_PUBLISHED || published.GetBlog().GetPublishedAt() == nil {
+		t.Fatalf("PublishBlog returned %v (%v)", published, err)
+	}
+
+	//an update can't change the status, it is kept from the stored blog
+	data := proto.Clone(published.GetBlog()).(*blogpb.Blog)
+	data.Title = "updated"
+	data.Status = blogpb.BlogStatus_BLOG_STATUS_DRAFT
+	updated, err := s.UpdateBlog(ctx, &blogpb.UpdateBlogRequest{Blog: data, ExpectedRevision: data.GetRevision()})
+	if err != nil || updated.GetBlog().GetStatus() != blogpb.BlogStatus_BLOG_STATUS_PUBLISHED {
+		t.Fatalf("UpdateBlog should keep the published status, got %v (%v)", updated, err)
+	}
+
+	archived, err := s.ArchiveBlog(ctx, &blogpb.ArchiveBlogRequest{BlogId: blog.GetId()})
+	if err != nil || archived.GetBlog().GetStatus() != blogpb.BlogStatus_BLOG_STATUS_ARCHIVED {
+		t.Fatalf("ArchiveBlog returned %v (%v)", archived, err)
+	}
+	draft, err := s.ReturnBlogToDraft(ctx, &blogpb.ReturnBlogToDraftRequest{BlogId: blog.GetId()})
+	if err != nil || draft.GetBlog().GetStatus() != blogpb.BlogStatus_BLOG_STATUS_DRAFT || draft.GetBlog().GetPublishedAt() != nil {
+		t.Errorf("ReturnBlogToDraft should clear the published_at, got %v (%v)", draft, err)
+	}
+}
+
+func TestPublishScheduled(t *testing.T) {
+	s, ctx := newTestServer(t)
+	authorID := createTestAuthor(t, s, ctx)
+
+	later := createTestBlog(t, s, ctx, authorID, "later")
+	due := createTestBlog(t, s, ctx, authorID, "due")
+	for _, blog := range []*blogpb.Blog{later, due} {
+		_, err := s.SubmitBlogForReview(ctx, &blogpb.SubmitBlogForReviewRequest{BlogId: blog.GetId()})
+		if err != nil {
+			t.Fatalf("SubmitBlogForReview: %v", err)
+		}
+	}
+
+	publishAt := time.Now().Add(time.Hour)
+	res, err := s.PublishBlog(ctx, &blogpb.PublishBlogRequest{BlogId: later.GetId(), PublishAt: timestamppb.New(publishAt)})
+	if err != nil || res.GetBlog().GetStatus() != blogpb.BlogStatus_BLOG_STATUS_SCHEDULED {
+		t.Fatalf("PublishBlog with a future publish_at should schedule the blog, got %v (%v)", res, err)
+	}
+	//the publish_at of this one has already passed, as if the server was down when it arrived
+	_, err = tenantFromContext(ctx).changeStatus(ctx, due.GetId(), 0, blogpb.BlogStatus_BLOG_STATUS_SCHEDULED, timestamppb.New(time.Now().Add(-time.Minute)))
+	if err != nil {
+		t.Fatalf("changeStatus: %v", err)
+	}
+
+	next := tenantFromContext(ctx).publishScheduled(ctx)
+	if !next.Equal(publishAt) {
+		t.Errorf("the next publish_at should be %v, got %v", publishAt, next)
+	}
+	for _, test := range []struct {
+		blog *blogpb.Blog
+		want blogpb.BlogStatus
+	}{
+		{later, blogpb.BlogStatus_BLOG_STATUS_SCHEDULED},
+		{due, blogpb.BlogStatus_BLOG_STATUS_PUBLISHED},
+	} {
+		res, err := s.ReadBlog(ctx, &blogpb.ReadBlogRequest{BlogId: test.blog.GetId()})
+		if err != nil || res.GetBlog().GetStatus() != test.want {
+			t.Errorf("the blog %v should be %v, got %v (%v)", test.blog.GetTitle(), test.want, res.GetBlog(), err)
+		}
+	}
+}
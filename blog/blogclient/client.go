@@ -0,0 +1,313 @@
+// Package blogclient is a client of the BlogService, for the programs that don't want to deal with the
+// generated code. It retries the requests when the server is unavailable, follows the cursors of the
+// listings and returns ErrNotFound or ErrConflict for the errors that the callers usually handle.
+//
+//	c, err := blogclient.Dial(ctx, "localhost:50051", blogclient.WithAPIKey(key))
+//	if err != nil {
+//		return err
+//	}
+//	defer c.Close()
+//
+//	it := c.ListBlogs(ctx, &blogpb.ListBlogsRequest{AuthorId: authorID})
+//	for it.Next() {
+//		fmt.Println(it.Blog().GetTitle())
+//	}
+//	return it.Err()
+package blogclient
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"encoding/hex"
+	"math/big"
+	"time"
+
+	"github.com/diegoclair/grpc-go-course/blog/blogpb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+const (
+	defaultMaxAttempts    = 4
+	defaultInitialBackoff = 100 * time.Millisecond
+	defaultMaxBackoff     = 2 * time.Second
+	defaultPageSize       = 100
+	maxPageSize           = 1000 //the biggest page_size accepted by the server
+)
+
+type options struct {
+	tlsConfig      *tls.Config
+	apiKey         string
+	maxAttempts    int
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+	pageSize       int32
+	dialOptions    []grpc.DialOption
+}
+
+// Option changes how the Client connects and retries
+type Option func(*options)
+
+// WithTLS connects with tls, without it the connection is insecure
+func WithTLS(config *tls.Config) Option {
+	return func(o *options) {
+		o.tlsConfig = config
+	}
+}
+
+// WithAPIKey sends the api key of the tenant in all the requests
+func WithAPIKey(key string) Option {
+	return func(o *options) {
+		o.apiKey = key
+	}
+}
+
+// WithRetry sets how many times a request is sent while the server is unavailable, 1 doesn't retry.
+// The wait between the attempts starts at initialBackoff and doubles up to maxBackoff.
+func WithRetry(maxAttempts int, initialBackoff, maxBackoff time.Duration) Option {
+	return func(o *options) {
+		o.maxAttempts = maxAttempts
+		o.initialBackoff = initialBackoff
+		o.maxBackoff = maxBackoff
+	}
+}
+
+// WithPageSize sets how many blogs are requested in each page of the listings, from 1 to 1000.
+// A size out of this range uses the default of 100.
+func WithPageSize(size int32) Option {
+	return func(o *options) {
+		o.pageSize = size
+	}
+}
+
+// WithDialOptions adds options to the grpc.DialContext of Dial
+func WithDialOptions(opts ...grpc.DialOption) Option {
+	return func(o *options) {
+		o.dialOptions = append(o.dialOptions, opts...)
+	}
+}
+
+// Client calls the BlogService. It is safe to use from several goroutines.
+type Client struct {
+	service blogpb.BlogServiceClient
+	conn    *grpc.ClientConn //nil when the connection belongs to the caller
+	opts    options
+}
+
+// Dial connects to the blog server. The connection is made in the background,
+// so Dial doesn't fail when the server is down, the requests are retried instead.
+func Dial(ctx context.Context, address string, opts ...Option) (*Client, error) {
+
+	o := newOptions(opts)
+	dialOptions := []grpc.DialOption{grpc.WithInsecure()}
+	if o.tlsConfig != nil {
+		dialOptions = []grpc.DialOption{grpc.WithTransportCredentials(credentials.NewTLS(o.tlsConfig))}
+	}
+	if o.apiKey != "" {
+		dialOptions = append(dialOptions, grpc.WithPerRPCCredentials(apiKeyCredentials(o.apiKey)))
+	}
+
+	conn, err := grpc.DialContext(ctx, address, append(dialOptions, o.dialOptions...)...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Client{
+		service: blogpb.NewBlogServiceClient(conn),
+		conn:    conn,
+		opts:    o,
+	}, nil
+}
+
+// New returns a Client that uses a connection of the caller, which is not closed by Close.
+// The WithTLS, WithAPIKey and WithDialOptions are ignored, they must be set in the connection.
+func New(conn grpc.ClientConnInterface, opts ...Option) *Client {
+	return &Client{
+		service: blogpb.NewBlogServiceClient(conn),
+		opts:    newOptions(opts),
+	}
+}
+
+func newOptions(opts []Option) options {
+	o := options{
+		maxAttempts:    defaultMaxAttempts,
+		initialBackoff: defaultInitialBackoff,
+		maxBackoff:     defaultMaxBackoff,
+		pageSize:       defaultPageSize,
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if o.maxAttempts < 1 {
+		o.maxAttempts = 1
+	}
+	//the server rejects a bigger page and a page of 0 would end the listing at the first page
+	if o.pageSize < 1 || o.pageSize > maxPageSize {
+		o.pageSize = defaultPageSize
+	}
+	return o
+}
+
+// Close closes the connection opened by Dial
+func (c *Client) Close() error {
+	if c.conn == nil {
+		return nil
+	}
+	return c.conn.Close()
+}
+
+// Service returns the generated client, for the rpcs that the Client doesn't wrap
+func (c *Client) Service() blogpb.BlogServiceClient {
+	return c.service
+}
+
+// CreateBlog creates the blog, the server fills its id when it is empty
+func (c *Client) CreateBlog(ctx context.Context, blog *blogpb.Blog) (*blogpb.Blog, error) {
+	var res *blogpb.CreateBlogResponse
+	err := c.retry(withIdempotencyKey(ctx), func(ctx context.Context) (err error) {
+		res, err = c.service.CreateBlog(ctx, &blogpb.CreateBlogRequest{Blog: blog})
+		return err
+	})
+	return res.GetBlog(), err
+}
+
+// GetBlog reads the blog, with only the fields when they are sent, like "title" and "tags"
+func (c *Client) GetBlog(ctx context.Context, blogID string, fields ...string) (*blogpb.Blog, error) {
+	var res *blogpb.ReadBlogResponse
+	err := c.retry(ctx, func(ctx context.Context) (err error) {
+		res, err = c.service.ReadBlog(ctx, &blogpb.ReadBlogRequest{BlogId: blogID, ReadMask: fieldMask(fields)})
+		return err
+	})
+	return res.GetBlog(), err
+}
+
+// UpdateBlog replaces the blog if it still has the expectedRevision, otherwise it returns ErrConflict.
+// When fields are sent only they are changed, like "title" and "tags".
+func (c *Client) UpdateBlog(ctx context.Context, blog *blogpb.Blog, expectedRevision int64, fields ...string) (*blogpb.Blog, error) {
+	var res *blogpb.UpdateBlogResponse
+	err := c.retry(withIdempotencyKey(ctx), func(ctx context.Context) (err error) {
+		res, err = c.service.UpdateBlog(ctx, &blogpb.UpdateBlogRequest{
+			Blog:             blog,
+			ExpectedRevision: expectedRevision,
+			UpdateMask:       fieldMask(fields),
+		})
+		return err
+	})
+	return res.GetBlog(), err
+}
+
+// DeleteBlog moves the blog to the trash, from where it can be restored
+func (c *Client) DeleteBlog(ctx context.Context, blogID string) error {
+	return c.deleteBlog(ctx, &blogpb.DeleteBlogRequest{BlogId: blogID})
+}
+
+// DeleteBlogPermanently removes the blog, even if it is in the trash.
+// If it has comments, they are removed with cascade, otherwise the server returns FAILED_PRECONDITION.
+func (c *Client) DeleteBlogPermanently(ctx context.Context, blogID string, cascade bool) error {
+	return c.deleteBlog(ctx, &blogpb.DeleteBlogRequest{BlogId: blogID, Permanent: true, Cascade: cascade})
+}
+
+func (c *Client) deleteBlog(ctx context.Context, req *blogpb.DeleteBlogRequest) error {
+	return c.retry(withIdempotencyKey(ctx), func(ctx context.Context) error {
+		_, err := c.service.DeleteBlog(ctx, req)
+		return err
+	})
+}
+
+// SearchBlogs returns the blogs that have all the words of the query, the most relevant first
+func (c *Client) SearchBlogs(ctx context.Context, query string, limit int32) ([]*blogpb.SearchResult, error) {
+	var res *blogpb.SearchBlogsResponse
+	err := c.retry(ctx, func(ctx context.Context) (err error) {
+		res, err = c.service.SearchBlogs(ctx, &blogpb.SearchBlogsRequest{Query: query, Limit: limit})
+		return err
+	})
+	return res.GetResults(), err
+}
+
+// ListBlogs returns an iterator over all the blogs of the filter, the pages are requested when they are needed.
+// The PageSize and Cursor of the filter are filled by the iterator, a nil filter lists all the blogs.
+func (c *Client) ListBlogs(ctx context.Context, filter *blogpb.ListBlogsRequest) *BlogIterator {
+	return newBlogIterator(ctx, c, filter)
+}
+
+// retry calls fn until it doesn't return UNAVAILABLE, the attempts end or the context is done
+func (c *Client) retry(ctx context.Context, fn func(ctx context.Context) error) error {
+	for attempt := 1; ; attempt++ {
+		err := fn(ctx)
+		if status.Code(err) != codes.Unavailable || attempt >= c.opts.maxAttempts || !c.wait(ctx, attempt) {
+			return convertError(err)
+		}
+	}
+}
+
+// wait sleeps before the next attempt, it returns false if the context is done before
+func (c *Client) wait(ctx context.Context, attempt int) bool {
+
+	backoff := c.opts.initialBackoff
+	for i := 1; i < attempt && backoff < c.opts.maxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > c.opts.maxBackoff {
+		backoff = c.opts.maxBackoff
+	}
+
+	timer := time.NewTimer(jitter(backoff))
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+// jitter returns a random wait between half and all the backoff, so the clients don't retry all together
+func jitter(backoff time.Duration) time.Duration {
+	if backoff <= 1 {
+		return backoff
+	}
+	n, err := rand.Int(rand.Reader, big.NewInt(int64(backoff/2)))
+	if err != nil {
+		return backoff
+	}
+	return backoff/2 + time.Duration(n.Int64())
+}
+
+// withIdempotencyKey adds a key to the metadata, so the server executes the request only once even if it is retried.
+// A key already in the context is kept, so the caller can use its own one.
+func withIdempotencyKey(ctx context.Context) context.Context {
+	md, _ := metadata.FromOutgoingContext(ctx)
+	if len(md.Get("idempotency-key")) > 0 {
+		return ctx
+	}
+	b := make([]byte, 16)
+	_, err := rand.Read(b)
+	if err != nil {
+		return ctx //without a key the request is still valid, only a retry could apply it twice
+	}
+	return metadata.AppendToOutgoingContext(ctx, "idempotency-key", hex.EncodeToString(b))
+}
+
+func fieldMask(fields []string) *fieldmaskpb.FieldMask {
+	if len(fields) == 0 {
+		return nil
+	}
+	return &fieldmaskpb.FieldMask{Paths: fields}
+}
+
+// apiKeyCredentials sends the api key in the metadata of all the requests
+type apiKeyCredentials string
+
+func (k apiKeyCredentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{"authorization": "Bearer " + string(k)}, nil
+}
+
+// RequireTransportSecurity is false so the key can be used with a local server without tls
+func (k apiKeyCredentials) RequireTransportSecurity() bool {
+	return false
+}
@@ -0,0 +1,278 @@
+package blogclient
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/diegoclair/grpc-go-course/blog/blogpb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// fakeServer answers the requests of the tests, the errors are returned in order before the real answer
+type fakeServer struct {
+	blogpb.UnimplementedBlogServiceServer
+
+	mu     sync.Mutex
+	errs   []error
+	calls  int
+	keys   []string //idempotency keys received
+	blogs  []*blogpb.Blog
+	breaks map[string]bool //cursors of the pages that break after their first blog
+	limit  int32           //max blogs of a page, 0 uses the page_size
+	pages  []*blogpb.ListBlogsRequest
+}
+
+func (f *fakeServer) nextError(ctx context.Context) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.calls++
+	md, _ := metadata.FromIncomingContext(ctx)
+	f.keys = append(f.keys, md.Get("idempotency-key")...)
+	if len(f.errs) == 0 {
+		return nil
+	}
+	err := f.errs[0]
+	f.errs = f.errs[1:]
+	return err
+}
+
+func (f *fakeServer) CreateBlog(ctx context.Context, req *blogpb.CreateBlogRequest) (*blogpb.CreateBlogResponse, error) {
+	if err := f.nextError(ctx); err != nil {
+		return nil, err
+	}
+	return &blogpb.CreateBlogResponse{Blog: &blogpb.Blog{Id: "created", Title: req.GetBlog().GetTitle()}}, nil
+}
+
+func (f *fakeServer) ReadBlog(ctx context.Context, req *blogpb.ReadBlogRequest) (*blogpb.ReadBlogResponse, error) {
+	if err := f.nextError(ctx); err != nil {
+		return nil, err
+	}
+	return &blogpb.ReadBlogResponse{Blog: &blogpb.Blog{Id: req.GetBlogId()}}, nil
+}
+
+func (f *fakeServer) UpdateBlog(ctx context.Context, req *blogpb.UpdateBlogRequest) (*blogpb.UpdateBlogResponse, error) {
+	if err := f.nextError(ctx); err != nil {
+		return nil, err
+	}
+	return &blogpb.UpdateBlogResponse{Blog: req.GetBlog()}, nil
+}
+
+// ListBlogs sends the blogs after the cursor, the cursor is the blog id
+func (f *fakeServer) ListBlogs(req *blogpb.ListBlogsRequest, stream blogpb.BlogService_ListBlogsServer) error {
+	if err := f.nextError(stream.Context()); err != nil {
+		return err
+	}
+	f.mu.Lock()
+	f.pages = append(f.pages, req)
+	breaks := f.breaks[req.GetCursor()]
+	delete(f.breaks, req.GetCursor())
+	f.mu.Unlock()
+
+	size := req.GetPageSize()
+	if f.limit > 0 && f.limit < size {
+		size = f.limit
+	}
+	sent := int32(0)
+	for _, blog := range f.blogs {
+		if blog.GetId() <= req.GetCursor() || sent == size {
+			continue
+		}
+		err := stream.Send(&blogpb.ListBlogsResponse{Blog: blog, Cursor: blog.GetId()})
+		if err != nil {
+			return err
+		}
+		sent++
+		if breaks {
+			return status.Errorf(codes.Unavailable, "the server is restarting")
+		}
+	}
+	return nil
+}
+
+// newTestClient starts the fake server in memory and returns a client connected to it
+func newTestClient(t *testing.T, f *fakeServer, opts ...Option) *Client {
+	t.Helper()
+
+	lis := bufconn.Listen(1 << 20)
+	s := grpc.NewServer()
+	blogpb.RegisterBlogServiceServer(s, f)
+	go s.Serve(lis)
+	t.Cleanup(s.Stop)
+
+	dialer := func(ctx context.Context, address string) (net.Conn, error) {
+		return lis.Dial()
+	}
+	opts = append([]Option{WithRetry(4, time.Millisecond, 4*time.Millisecond), WithDialOptions(grpc.WithContextDialer(dialer))}, opts...)
+	c, err := Dial(context.Background(), "bufnet", opts...)
+	if err != nil {
+		t.Fatalf("Dial: %v", err)
+	}
+	t.Cleanup(func() { c.Close() })
+	return c
+}
+
+func unavailable() error {
+	return status.Errorf(codes.Unavailable, "the server is down")
+}
+
+func TestRetryUnavailable(t *testing.T) {
+	f := &fakeServer{errs: []error{unavailable(), unavailable()}}
+	c := newTestClient(t, f)
+
+	blog, err := c.CreateBlog(context.Background(), &blogpb.Blog{Title: "title"})
+	if err != nil || blog.GetId() != "created" {
+		t.Fatalf("CreateBlog returned %v (%v)", blog, err)
+	}
+	if f.calls != 3 {
+		t.Errorf("the request should be sent 3 times, it was sent %v", f.calls)
+	}
+	//the server must see the retries as the same request
+	if len(f.keys) != 3 || f.keys[0] == "" || f.keys[1] != f.keys[0] || f.keys[2] != f.keys[0] {
+		t.Errorf("the retries should have the same idempotency key, got %v", f.keys)
+	}
+}
+
+func TestRetryGivesUp(t *testing.T) {
+	f := &fakeServer{errs: []error{unavailable(), unavailable(), unavailable(), unavailable(), unavailable()}}
+	c := newTestClient(t, f, WithRetry(3, time.Millisecond, time.Millisecond))
+
+	_, err := c.GetBlog(context.Background(), "a")
+	if status.Code(err) != codes.Unavailable || f.calls != 3 {
+		t.Errorf("GetBlog should fail with UNAVAILABLE after 3 attempts, got %v after %v", err, f.calls)
+	}
+
+	//the backoff doesn't wait past the context
+	f = &fakeServer{errs: []error{unavailable(), unavailable()}}
+	c = newTestClient(t, f, WithRetry(3, time.Hour, time.Hour))
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	_, err = c.GetBlog(ctx, "a")
+	if status.Code(err) != codes.Unavailable || f.calls != 1 || time.Since(start) > time.Second {
+		t.Errorf("GetBlog should stop at the deadline, got %v after %v calls and %v", err, f.calls, time.Since(start))
+	}
+}
+
+func TestJitter(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		if d := jitter(time.Second); d < time.Second/2 || d > time.Second {
+			t.Fatalf("jitter(1s) = %v, want between 500ms and 1s", d)
+		}
+	}
+}
+
+func TestTypedErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		err   error
+		kind  error
+		calls int
+	}{
+		{"not found", status.Errorf(codes.NotFound, "Cannot find blog"), ErrNotFound, 1},
+		{"already exists", status.Errorf(codes.AlreadyExists, "A blog already exists"), ErrConflict, 1},
+		{"old revision", status.Errorf(codes.Aborted, "The blog was changed"), ErrConflict, 1},
+		{"invalid argument", status.Errorf(codes.InvalidArgument, "The title is required"), nil, 1},
+	}
+
+	for _, test := range tests {
+		f := &fakeServer{errs: []error{test.err}}
+		c := newTestClient(t, f)
+
+		_, err := c.UpdateBlog(context.Background(), &blogpb.Blog{Id: "a"}, 1)
+		if test.kind != nil && !errors.Is(err, test.kind) {
+			t.Errorf("%v: UpdateBlog returned %v, want %v", test.name, err, test.kind)
+		}
+		if test.kind == nil && (errors.Is(err, ErrNotFound) || errors.Is(err, ErrConflict)) {
+			t.Errorf("%v: UpdateBlog returned %v, want an error without a kind", test.name, err)
+		}
+		//the status is still there for the callers that want the code or the details
+		if status.Code(err) != status.Code(test.err) || f.calls != test.calls {
+			t.Errorf("%v: UpdateBlog returned %v after %v calls, want %v without retries", test.name, err, f.calls, status.Code(test.err))
+		}
+	}
+}
+
+// listAll returns the ids of all the blogs of the iterator
+func listAll(t *testing.T, it *BlogIterator) []string {
+	t.Helper()
+
+	ids := make([]string, 0)
+	for it.Next() {
+		ids = append(ids, it.Blog().GetId())
+	}
+	if err := it.Err(); err != nil {
+		t.Fatalf("the iterator failed: %v", err)
+	}
+	return ids
+}
+
+func TestBlogIterator(t *testing.T) {
+	f := &fakeServer{
+		blogs:  []*blogpb.Blog{{Id: "a"}, {Id: "b"}, {Id: "c"}, {Id: "d"}, {Id: "e"}},
+		breaks: map[string]bool{"b": true},
+	}
+	c := newTestClient(t, f, WithPageSize(2))
+
+	//the second page breaks after the c, so it is requested again after the c, and the last full page is followed by an empty one
+	ids := listAll(t, c.ListBlogs(context.Background(), &blogpb.ListBlogsRequest{AuthorId: "author"}))
+	if len(ids) != 5 || ids[0] != "a" || ids[2] != "c" || ids[3] != "d" || ids[4] != "e" {
+		t.Fatalf("the iterator returned %v, want all the blogs once", ids)
+	}
+	cursors := make([]string, 0)
+	for _, page := range f.pages {
+		if page.GetAuthorId() != "author" || page.GetPageSize() != 2 {
+			t.Errorf("the page %v should keep the filter and the page size", page)
+		}
+		cursors = append(cursors, page.GetCursor())
+	}
+	if len(cursors) != 4 || cursors[1] != "b" || cursors[2] != "c" || cursors[3] != "e" {
+		t.Errorf("the pages should start after the last blog received, got the cursors %v", cursors)
+	}
+
+	//the pages of the server are smaller than the page_size, the listing goes on until an empty page
+	f = &fakeServer{blogs: []*blogpb.Blog{{Id: "a"}, {Id: "b"}, {Id: "c"}}, limit: 1}
+	if ids := listAll(t, newTestClient(t, f, WithPageSize(2)).ListBlogs(context.Background(), nil)); len(ids) != 3 || len(f.pages) != 4 {
+		t.Errorf("the iterator with small pages returned %v after %v pages, want the 3 blogs after 4 pages", ids, len(f.pages))
+	}
+
+	//a nil filter lists all the blogs
+	f = &fakeServer{blogs: []*blogpb.Blog{{Id: "a"}}}
+	if ids := listAll(t, newTestClient(t, f).ListBlogs(context.Background(), nil)); len(ids) != 1 {
+		t.Errorf("the iterator without filter returned %v, want all the blogs", ids)
+	}
+
+	f = &fakeServer{errs: []error{status.Errorf(codes.PermissionDenied, "no")}}
+	it := newTestClient(t, f).ListBlogs(context.Background(), &blogpb.ListBlogsRequest{})
+	if it.Next() || status.Code(it.Err()) != codes.PermissionDenied {
+		t.Errorf("the iterator should stop with the error of the server, got %v", it.Err())
+	}
+}
+
+func TestPageSizeOption(t *testing.T) {
+	tests := []struct {
+		size int32
+		want int32
+	}{
+		{1, 1},
+		{500, 500},
+		{maxPageSize, maxPageSize},
+		{0, defaultPageSize},
+		{-1, defaultPageSize},
+		{maxPageSize + 1, defaultPageSize},
+	}
+
+	for _, test := range tests {
+		if got := newOptions([]Option{WithPageSize(test.size)}).pageSize; got != test.want {
+			t.Errorf("WithPageSize(%v) uses pages of %v, want %v", test.size, got, test.want)
+		}
+	}
+}
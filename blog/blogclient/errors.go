@@ -0,0 +1,54 @@
+package blogclient
+
+import (
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	// ErrNotFound is returned when the blog doesn't exist or is in the trash
+	ErrNotFound = errors.New("blogclient: not found")
+	// ErrConflict is returned when the blog already exists or was changed after the expected revision,
+	// the caller should read it again before retrying
+	ErrConflict = errors.New("blogclient: conflict")
+)
+
+// Error is a grpc error of the server, errors.Is matches it with ErrNotFound or ErrConflict
+// and status.FromError still returns its status
+type Error struct {
+	kind   error
+	status *status.Status
+}
+
+func (e *Error) Error() string {
+	return e.status.Err().Error()
+}
+
+// Unwrap returns ErrNotFound or ErrConflict
+func (e *Error) Unwrap() error {
+	return e.kind
+}
+
+// GRPCStatus returns the status sent by the server, with its code, message and details
+func (e *Error) GRPCStatus() *status.Status {
+	return e.status
+}
+
+// convertError wraps the status errors that have a kind, the others are returned as they are
+func convertError(err error) error {
+
+	st, ok := status.FromError(err)
+	if err == nil || !ok {
+		return err
+	}
+
+	switch st.Code() {
+	case codes.NotFound:
+		return &Error{kind: ErrNotFound, status: st}
+	case codes.AlreadyExists, codes.Aborted:
+		return &Error{kind: ErrConflict, status: st}
+	}
+	return err
+}
@@ -0,0 +1,136 @@
+package blogclient
+
+import (
+	"context"
+	"io"
+
+	"github.com/diegoclair/grpc-go-course/blog/blogpb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// BlogIterator returns the blogs of a listing one by one, like:
+//
+//	for it.Next() {
+//		blog := it.Blog()
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+//
+// It requests the next page with the cursor of the last blog, so a page that breaks in the middle
+// is requested again from where it stopped, without repeating the blogs.
+type BlogIterator struct {
+	ctx    context.Context
+	client *Client
+	req    *blogpb.ListBlogsRequest
+
+	stream   blogpb.BlogService_ListBlogsClient
+	cancel   context.CancelFunc //cancels the stream of the current page
+	received int32              //blogs received in the current page
+	attempt  int                //failed attempts of the current page
+	blog     *blogpb.Blog
+	err      error
+	done     bool
+}
+
+func newBlogIterator(ctx context.Context, c *Client, filter *blogpb.ListBlogsRequest) *BlogIterator {
+	req := &blogpb.ListBlogsRequest{}
+	if filter != nil {
+		req = proto.Clone(filter).(*blogpb.ListBlogsRequest)
+	}
+	req.PageSize = c.opts.pageSize
+	return &BlogIterator{
+		ctx:    ctx,
+		client: c,
+		req:    req,
+	}
+}
+
+// Next moves to the next blog, it returns false at the end of the listing or after an error
+func (it *BlogIterator) Next() bool {
+
+	for !it.done {
+		if it.stream == nil {
+			it.openPage()
+			continue
+		}
+
+		res, err := it.stream.Recv()
+		if err == io.EOF {
+			it.closePage()
+			//the server can send less blogs than the page_size before the end, like one with a smaller max page,
+			//so only an empty page ends the listing
+			if it.received == 0 {
+				it.done = true
+			}
+			continue
+		}
+		if err != nil {
+			it.closePage()
+			it.fail(err)
+			continue
+		}
+
+		it.blog = res.GetBlog()
+		it.req.Cursor = res.GetCursor()
+		it.received++
+		it.attempt = 0
+		return true
+	}
+
+	it.blog = nil
+	return false
+}
+
+// Blog returns the current blog, after a call to Next that returned true
+func (it *BlogIterator) Blog() *blogpb.Blog {
+	return it.blog
+}
+
+// Err returns the error that stopped the listing, it is nil when all the blogs were returned
+func (it *BlogIterator) Err() error {
+	return it.err
+}
+
+// Close stops the listing before its end, it is not needed when Next has returned false
+func (it *BlogIterator) Close() {
+	it.closePage()
+	it.done = true
+}
+
+func (it *BlogIterator) openPage() {
+
+	//the page starts after the cursor of the last blog, so the blogs of a broken page are not repeated
+	ctx, cancel := context.WithCancel(it.ctx)
+	stream, err := it.client.service.ListBlogs(ctx, it.req)
+	if err != nil {
+		cancel()
+		it.fail(err)
+		return
+	}
+	it.stream = stream
+	it.cancel = cancel
+	it.received = 0
+}
+
+func (it *BlogIterator) closePage() {
+	if it.cancel != nil {
+		it.cancel()
+	}
+	it.stream = nil
+	it.cancel = nil
+}
+
+// fail retries the page when the server is unavailable, like the other requests, otherwise it stops the listing
+func (it *BlogIterator) fail(err error) {
+
+	it.attempt++
+	if status.Code(err) == codes.Unavailable && it.attempt < it.client.opts.maxAttempts && it.client.wait(it.ctx, it.attempt) {
+		return
+	}
+
+	it.err = convertError(err)
+	it.done = true
+}
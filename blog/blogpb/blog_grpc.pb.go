@@ -3,7 +3,10 @@
 package blogpb
 
 import (
+	context "context"
 	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
 )
 
 // This is a compile-time assertion to ensure that this generated file
@@ -14,6 +17,149 @@ const _ = grpc.SupportPackageIsVersion7
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type BlogServiceClient interface {
+	// Unary
+	// if the blog is sent with an id that already exists, it returns ALREADY_EXISTS
+	// if the author_id is not of an existing author, it returns FAILED_PRECONDITION
+	CreateBlog(ctx context.Context, in *CreateBlogRequest, opts ...grpc.CallOption) (*CreateBlogResponse, error)
+	// Unary
+	// the read_mask of the read and list rpcs chooses the returned Blog fields, like ["title", "tags"]
+	// return NOT_FOUND if the blog is not found
+	// return INVALID_ARGUMENT if the read_mask has a path that is not a Blog field
+	ReadBlog(ctx context.Context, in *ReadBlogRequest, opts ...grpc.CallOption) (*ReadBlogResponse, error)
+	// Unary
+	// return NOT_FOUND if the blog is not found
+	// return ABORTED if the blog was changed after expected_revision, the client should read it again and retry
+	// return FAILED_PRECONDITION if the author_id is not of an existing author
+	// return INVALID_ARGUMENT if the update_mask has a path that is not a Blog field or is filled by the server
+	UpdateBlog(ctx context.Context, in *UpdateBlogRequest, opts ...grpc.CallOption) (*UpdateBlogResponse, error)
+	// Unary
+	// moves the blog to the trash, where it is kept until the retention period of the server expires
+	// when permanent is true the blog is removed right away, even if it is in the trash
+	// return NOT_FOUND if the blog is not found
+	// return FAILED_PRECONDITION if permanent is true, the blog has comments and cascade is false
+	DeleteBlog(ctx context.Context, in *DeleteBlogRequest, opts ...grpc.CallOption) (*DeleteBlogResponse, error)
+	// ServerStreaming
+	// streams the blogs in the trash ordered by id, with the same pagination of ListBlogs
+	ListDeletedBlogs(ctx context.Context, in *ListDeletedBlogsRequest, opts ...grpc.CallOption) (BlogService_ListDeletedBlogsClient, error)
+	// Unary
+	// moves the blog back from the trash
+	// return NOT_FOUND if the blog is not in the trash
+	RestoreBlog(ctx context.Context, in *RestoreBlogRequest, opts ...grpc.CallOption) (*RestoreBlogResponse, error)
+	// ServerStreaming
+	// streams the blogs ordered by id, up to page_size blogs per call
+	// to get the next page, call it again with the cursor of the last received blog
+	// the blogs can be filtered by author, category and tags, all the filters must match
+	ListBlogs(ctx context.Context, in *ListBlogsRequest, opts ...grpc.CallOption) (BlogService_ListBlogsClient, error)
+	// Unary
+	// returns all the tags of the blogs with how many blogs have each one, the most used first
+	ListTags(ctx context.Context, in *ListTagsRequest, opts ...grpc.CallOption) (*ListTagsResponse, error)
+	// Unary
+	// moves a draft to review
+	// the workflow rpcs return NOT_FOUND if the blog is not found,
+	// FAILED_PRECONDITION if the blog status doesn't allow the change
+	// and ABORTED if expected_revision is filled and the blog was changed after it
+	SubmitBlogForReview(ctx context.Context, in *SubmitBlogForReviewRequest, opts ...grpc.CallOption) (*SubmitBlogForReviewResponse, error)
+	// Unary
+	// publishes a blog in review, or schedules it when publish_at is in the future
+	// a scheduled blog can also be published before its time
+	PublishBlog(ctx context.Context, in *PublishBlogRequest, opts ...grpc.CallOption) (*PublishBlogResponse, error)
+	// Unary
+	// archives a published blog
+	ArchiveBlog(ctx context.Context, in *ArchiveBlogRequest, opts ...grpc.CallOption) (*ArchiveBlogResponse, error)
+	// Unary
+	// moves the blog back to draft, from any other status
+	ReturnBlogToDraft(ctx context.Context, in *ReturnBlogToDraftRequest, opts ...grpc.CallOption) (*ReturnBlogToDraftResponse, error)
+	// Unary
+	// converts the markdown content of the blog to sanitised html, with its table of contents and an excerpt
+	// the result is cached until the blog changes
+	// return NOT_FOUND if the blog is not found
+	RenderBlog(ctx context.Context, in *RenderBlogRequest, opts ...grpc.CallOption) (*RenderBlogResponse, error)
+	// Unary
+	// return all the revisions of the blog, from the oldest to the current one
+	// return NOT_FOUND if the blog is not found
+	GetBlogHistory(ctx context.Context, in *GetBlogHistoryRequest, opts ...grpc.CallOption) (*GetBlogHistoryResponse, error)
+	// ServerStreaming
+	// streams the blog changes until the client cancels the request
+	// send the resume_token of the last received event to not miss the events that happened while disconnected
+	// return OUT_OF_RANGE if the resume_token is too old, the client should read the blogs again and watch without a token
+	// return RESOURCE_EXHAUSTED if the client is too slow to receive the events, it should reconnect with the last resume_token,
+	// that is also in the ErrorInfo detail
+	WatchBlogs(ctx context.Context, in *WatchBlogsRequest, opts ...grpc.CallOption) (BlogService_WatchBlogsClient, error)
+	// Unary
+	// search the published blogs by the words of the title and content, the best matches come first
+	// words inside double quotes are matched as a phrase, example: grpc "server streaming"
+	SearchBlogs(ctx context.Context, in *SearchBlogsRequest, opts ...grpc.CallOption) (*SearchBlogsResponse, error)
+	// ClientStreaming
+	// creates each received author and blog keeping its id and timestamps, a record that fails doesn't stop the import
+	// the authors must be sent before their blogs, an author that already exists is kept as it is
+	// the response has the errors of each failed record
+	ImportBlogs(ctx context.Context, opts ...grpc.CallOption) (BlogService_ImportBlogsClient, error)
+	// ServerStreaming
+	// streams all the authors and then all the blogs, ordered by id, so the export can be imported in an empty server
+	ExportBlogs(ctx context.Context, in *ExportBlogsRequest, opts ...grpc.CallOption) (BlogService_ExportBlogsClient, error)
+	// Unary
+	// if the author is sent with an id that already exists, it returns ALREADY_EXISTS
+	CreateAuthor(ctx context.Context, in *CreateAuthorRequest, opts ...grpc.CallOption) (*CreateAuthorResponse, error)
+	// Unary
+	// return NOT_FOUND if the author is not found
+	GetAuthor(ctx context.Context, in *GetAuthorRequest, opts ...grpc.CallOption) (*GetAuthorResponse, error)
+	// ServerStreaming
+	// streams the authors ordered by id, with the same pagination of ListBlogs
+	ListAuthors(ctx context.Context, in *ListAuthorsRequest, opts ...grpc.CallOption) (BlogService_ListAuthorsClient, error)
+	// Unary
+	// return FAILED_PRECONDITION if the blog or the author doesn't exist
+	CreateComment(ctx context.Context, in *CreateCommentRequest, opts ...grpc.CallOption) (*CreateCommentResponse, error)
+	// ServerStreaming
+	// streams the comments of a blog ordered by id, with the same pagination of ListBlogs
+	// return NOT_FOUND if the blog is not found
+	ListComments(ctx context.Context, in *ListCommentsRequest, opts ...grpc.CallOption) (BlogService_ListCommentsClient, error)
+	// Unary
+	// return NOT_FOUND if the comment is not found
+	DeleteComment(ctx context.Context, in *DeleteCommentRequest, opts ...grpc.CallOption) (*DeleteCommentResponse, error)
+	// ClientStreaming
+	// the first message must have the metadata, the next ones the chunks of the file
+	// and the last one the sha256 of the whole file, that is checked by the server
+	// return FAILED_PRECONDITION if the blog doesn't exist
+	// return INVALID_ARGUMENT if the file is bigger than the limit of the server or its content type is not allowed
+	// return DATA_LOSS if the sha256 doesn't match the received file
+	UploadAttachment(ctx context.Context, opts ...grpc.CallOption) (BlogService_UploadAttachmentClient, error)
+	// ServerStreaming
+	// the first message has the attachment, and all of them have a chunk of the file
+	// an interrupted download can be resumed sending the offset of the bytes already received
+	// return NOT_FOUND if the attachment is not found
+	// return OUT_OF_RANGE if the offset is after the end of the file
+	DownloadAttachment(ctx context.Context, in *DownloadAttachmentRequest, opts ...grpc.CallOption) (BlogService_DownloadAttachmentClient, error)
+	// Unary
+	// returns the attachments of the blog, the oldest first
+	ListAttachments(ctx context.Context, in *ListAttachmentsRequest, opts ...grpc.CallOption) (*ListAttachmentsResponse, error)
+	// Unary
+	// counts one more view of the blog, the clients call it when a reader opens the blog
+	// return NOT_FOUND if the blog is not found
+	RecordView(ctx context.Context, in *RecordViewRequest, opts ...grpc.CallOption) (*RecordViewResponse, error)
+	// Unary
+	// returns how many published blogs, words and views each author has, the authors with more blogs first
+	// the blogs that are not published or are in the trash are not counted
+	GetAuthorStats(ctx context.Context, in *GetAuthorStatsRequest, opts ...grpc.CallOption) (*GetAuthorStatsResponse, error)
+	// Unary
+	// returns how many blogs were published in each day of the range, including the days without blogs
+	// the blogs that are not published anymore or are in the trash are not counted
+	// return INVALID_ARGUMENT if the dates or the time zone are invalid, or the range has more than 366 days
+	GetPostsPerDay(ctx context.Context, in *GetPostsPerDayRequest, opts ...grpc.CallOption) (*GetPostsPerDayResponse, error)
+	// Unary
+	// returns the published blogs with more views, the blogs without views are not returned
+	ListMostViewedBlogs(ctx context.Context, in *ListMostViewedBlogsRequest, opts ...grpc.CallOption) (*ListMostViewedBlogsResponse, error)
+	// Unary
+	// applies up to 100 create, update and delete operations in order, all of them or none
+	// an operation sees the changes of the previous ones, so a blog can be created and updated in the same batch
+	// the deletes only move the blogs to the trash, permanent deletes are not accepted
+	// return ABORTED if an operation fails, with an ErrorInfo detail that has its index and error code
+	// return RESOURCE_EXHAUSTED if the tenant has no quota for the whole batch
+	// return FAILED_PRECONDITION if the storage is a standalone mongo, the batches need the transactions of a replica set
+	BatchWriteBlogs(ctx context.Context, in *BatchWriteBlogsRequest, opts ...grpc.CallOption) (*BatchWriteBlogsResponse, error)
+	// Unary
+	// returns the counters of the cache of the blogs and list pages of the tenant, for the monitoring
+	// the counters start at zero when the server starts
+	GetCacheStats(ctx context.Context, in *GetCacheStatsRequest, opts ...grpc.CallOption) (*GetCacheStatsResponse, error)
 }
 
 type blogServiceClient struct {
@@ -24,10 +170,661 @@ func NewBlogServiceClient(cc grpc.ClientConnInterface) BlogServiceClient {
 	return &blogServiceClient{cc}
 }
 
+func (c *blogServiceClient) CreateBlog(ctx context.Context, in *CreateBlogRequest, opts ...grpc.CallOption) (*CreateBlogResponse, error) {
+	out := new(CreateBlogResponse)
+	err := c.cc.Invoke(ctx, "/blog.BlogService/CreateBlog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogServiceClient) ReadBlog(ctx context.Context, in *ReadBlogRequest, opts ...grpc.CallOption) (*ReadBlogResponse, error) {
+	out := new(ReadBlogResponse)
+	err := c.cc.Invoke(ctx, "/blog.BlogService/ReadBlog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogServiceClient) UpdateBlog(ctx context.Context, in *UpdateBlogRequest, opts ...grpc.CallOption) (*UpdateBlogResponse, error) {
+	out := new(UpdateBlogResponse)
+	err := c.cc.Invoke(ctx, "/blog.BlogService/UpdateBlog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogServiceClient) DeleteBlog(ctx context.Context, in *DeleteBlogRequest, opts ...grpc.CallOption) (*DeleteBlogResponse, error) {
+	out := new(DeleteBlogResponse)
+	err := c.cc.Invoke(ctx, "/blog.BlogService/DeleteBlog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogServiceClient) ListDeletedBlogs(ctx context.Context, in *ListDeletedBlogsRequest, opts ...grpc.CallOption) (BlogService_ListDeletedBlogsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_BlogService_serviceDesc.Streams[0], "/blog.BlogService/ListDeletedBlogs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &blogServiceListDeletedBlogsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BlogService_ListDeletedBlogsClient interface {
+	Recv() (*ListDeletedBlogsResponse, error)
+	grpc.ClientStream
+}
+
+type blogServiceListDeletedBlogsClient struct {
+	grpc.ClientStream
+}
+
+func (x *blogServiceListDeletedBlogsClient) Recv() (*ListDeletedBlogsResponse, error) {
+	m := new(ListDeletedBlogsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *blogServiceClient) RestoreBlog(ctx context.Context, in *RestoreBlogRequest, opts ...grpc.CallOption) (*RestoreBlogResponse, error) {
+	out := new(RestoreBlogResponse)
+	err := c.cc.Invoke(ctx, "/blog.BlogService/RestoreBlog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogServiceClient) ListBlogs(ctx context.Context, in *ListBlogsRequest, opts ...grpc.CallOption) (BlogService_ListBlogsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_BlogService_serviceDesc.Streams[1], "/blog.BlogService/ListBlogs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &blogServiceListBlogsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BlogService_ListBlogsClient interface {
+	Recv() (*ListBlogsResponse, error)
+	grpc.ClientStream
+}
+
+type blogServiceListBlogsClient struct {
+	grpc.ClientStream
+}
+
+func (x *blogServiceListBlogsClient) Recv() (*ListBlogsResponse, error) {
+	m := new(ListBlogsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *blogServiceClient) ListTags(ctx context.Context, in *ListTagsRequest, opts ...grpc.CallOption) (*ListTagsResponse, error) {
+	out := new(ListTagsResponse)
+	err := c.cc.Invoke(ctx, "/blog.BlogService/ListTags", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogServiceClient) SubmitBlogForReview(ctx context.Context, in *SubmitBlogForReviewRequest, opts ...grpc.CallOption) (*SubmitBlogForReviewResponse, error) {
+	out := new(SubmitBlogForReviewResponse)
+	err := c.cc.Invoke(ctx, "/blog.BlogService/SubmitBlogForReview", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogServiceClient) PublishBlog(ctx context.Context, in *PublishBlogRequest, opts ...grpc.CallOption) (*PublishBlogResponse, error) {
+	out := new(PublishBlogResponse)
+	err := c.cc.Invoke(ctx, "/blog.BlogService/PublishBlog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogServiceClient) ArchiveBlog(ctx context.Context, in *ArchiveBlogRequest, opts ...grpc.CallOption) (*ArchiveBlogResponse, error) {
+	out := new(ArchiveBlogResponse)
+	err := c.cc.Invoke(ctx, "/blog.BlogService/ArchiveBlog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogServiceClient) ReturnBlogToDraft(ctx context.Context, in *ReturnBlogToDraftRequest, opts ...grpc.CallOption) (*ReturnBlogToDraftResponse, error) {
+	out := new(ReturnBlogToDraftResponse)
+	err := c.cc.Invoke(ctx, "/blog.BlogService/ReturnBlogToDraft", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogServiceClient) RenderBlog(ctx context.Context, in *RenderBlogRequest, opts ...grpc.CallOption) (*RenderBlogResponse, error) {
+	out := new(RenderBlogResponse)
+	err := c.cc.Invoke(ctx, "/blog.BlogService/RenderBlog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogServiceClient) GetBlogHistory(ctx context.Context, in *GetBlogHistoryRequest, opts ...grpc.CallOption) (*GetBlogHistoryResponse, error) {
+	out := new(GetBlogHistoryResponse)
+	err := c.cc.Invoke(ctx, "/blog.BlogService/GetBlogHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogServiceClient) WatchBlogs(ctx context.Context, in *WatchBlogsRequest, opts ...grpc.CallOption) (BlogService_WatchBlogsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_BlogService_serviceDesc.Streams[2], "/blog.BlogService/WatchBlogs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &blogServiceWatchBlogsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BlogService_WatchBlogsClient interface {
+	Recv() (*WatchBlogsResponse, error)
+	grpc.ClientStream
+}
+
+type blogServiceWatchBlogsClient struct {
+	grpc.ClientStream
+}
+
+func (x *blogServiceWatchBlogsClient) Recv() (*WatchBlogsResponse, error) {
+	m := new(WatchBlogsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *blogServiceClient) SearchBlogs(ctx context.Context, in *SearchBlogsRequest, opts ...grpc.CallOption) (*SearchBlogsResponse, error) {
+	out := new(SearchBlogsResponse)
+	err := c.cc.Invoke(ctx, "/blog.BlogService/SearchBlogs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogServiceClient) ImportBlogs(ctx context.Context, opts ...grpc.CallOption) (BlogService_ImportBlogsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_BlogService_serviceDesc.Streams[3], "/blog.BlogService/ImportBlogs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &blogServiceImportBlogsClient{stream}
+	return x, nil
+}
+
+type BlogService_ImportBlogsClient interface {
+	Send(*ImportBlogsRequest) error
+	CloseAndRecv() (*ImportBlogsResponse, error)
+	grpc.ClientStream
+}
+
+type blogServiceImportBlogsClient struct {
+	grpc.ClientStream
+}
+
+func (x *blogServiceImportBlogsClient) Send(m *ImportBlogsRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *blogServiceImportBlogsClient) CloseAndRecv() (*ImportBlogsResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(ImportBlogsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *blogServiceClient) ExportBlogs(ctx context.Context, in *ExportBlogsRequest, opts ...grpc.CallOption) (BlogService_ExportBlogsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_BlogService_serviceDesc.Streams[4], "/blog.BlogService/ExportBlogs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &blogServiceExportBlogsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BlogService_ExportBlogsClient interface {
+	Recv() (*ExportBlogsResponse, error)
+	grpc.ClientStream
+}
+
+type blogServiceExportBlogsClient struct {
+	grpc.ClientStream
+}
+
+func (x *blogServiceExportBlogsClient) Recv() (*ExportBlogsResponse, error) {
+	m := new(ExportBlogsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *blogServiceClient) CreateAuthor(ctx context.Context, in *CreateAuthorRequest, opts ...grpc.CallOption) (*CreateAuthorResponse, error) {
+	out := new(CreateAuthorResponse)
+	err := c.cc.Invoke(ctx, "/blog.BlogService/CreateAuthor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogServiceClient) GetAuthor(ctx context.Context, in *GetAuthorRequest, opts ...grpc.CallOption) (*GetAuthorResponse, error) {
+	out := new(GetAuthorResponse)
+	err := c.cc.Invoke(ctx, "/blog.BlogService/GetAuthor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogServiceClient) ListAuthors(ctx context.Context, in *ListAuthorsRequest, opts ...grpc.CallOption) (BlogService_ListAuthorsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_BlogService_serviceDesc.Streams[5], "/blog.BlogService/ListAuthors", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &blogServiceListAuthorsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BlogService_ListAuthorsClient interface {
+	Recv() (*ListAuthorsResponse, error)
+	grpc.ClientStream
+}
+
+type blogServiceListAuthorsClient struct {
+	grpc.ClientStream
+}
+
+func (x *blogServiceListAuthorsClient) Recv() (*ListAuthorsResponse, error) {
+	m := new(ListAuthorsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *blogServiceClient) CreateComment(ctx context.Context, in *CreateCommentRequest, opts ...grpc.CallOption) (*CreateCommentResponse, error) {
+	out := new(CreateCommentResponse)
+	err := c.cc.Invoke(ctx, "/blog.BlogService/CreateComment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogServiceClient) ListComments(ctx context.Context, in *ListCommentsRequest, opts ...grpc.CallOption) (BlogService_ListCommentsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_BlogService_serviceDesc.Streams[6], "/blog.BlogService/ListComments", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &blogServiceListCommentsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BlogService_ListCommentsClient interface {
+	Recv() (*ListCommentsResponse, error)
+	grpc.ClientStream
+}
+
+type blogServiceListCommentsClient struct {
+	grpc.ClientStream
+}
+
+func (x *blogServiceListCommentsClient) Recv() (*ListCommentsResponse, error) {
+	m := new(ListCommentsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *blogServiceClient) DeleteComment(ctx context.Context, in *DeleteCommentRequest, opts ...grpc.CallOption) (*DeleteCommentResponse, error) {
+	out := new(DeleteCommentResponse)
+	err := c.cc.Invoke(ctx, "/blog.BlogService/DeleteComment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogServiceClient) UploadAttachment(ctx context.Context, opts ...grpc.CallOption) (BlogService_UploadAttachmentClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_BlogService_serviceDesc.Streams[7], "/blog.BlogService/UploadAttachment", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &blogServiceUploadAttachmentClient{stream}
+	return x, nil
+}
+
+type BlogService_UploadAttachmentClient interface {
+	Send(*UploadAttachmentRequest) error
+	CloseAndRecv() (*UploadAttachmentResponse, error)
+	grpc.ClientStream
+}
+
+type blogServiceUploadAttachmentClient struct {
+	grpc.ClientStream
+}
+
+func (x *blogServiceUploadAttachmentClient) Send(m *UploadAttachmentRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *blogServiceUploadAttachmentClient) CloseAndRecv() (*UploadAttachmentResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(UploadAttachmentResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *blogServiceClient) DownloadAttachment(ctx context.Context, in *DownloadAttachmentRequest, opts ...grpc.CallOption) (BlogService_DownloadAttachmentClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_BlogService_serviceDesc.Streams[8], "/blog.BlogService/DownloadAttachment", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &blogServiceDownloadAttachmentClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BlogService_DownloadAttachmentClient interface {
+	Recv() (*DownloadAttachmentResponse, error)
+	grpc.ClientStream
+}
+
+type blogServiceDownloadAttachmentClient struct {
+	grpc.ClientStream
+}
+
+func (x *blogServiceDownloadAttachmentClient) Recv() (*DownloadAttachmentResponse, error) {
+	m := new(DownloadAttachmentResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *blogServiceClient) ListAttachments(ctx context.Context, in *ListAttachmentsRequest, opts ...grpc.CallOption) (*ListAttachmentsResponse, error) {
+	out := new(ListAttachmentsResponse)
+	err := c.cc.Invoke(ctx, "/blog.BlogService/ListAttachments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogServiceClient) RecordView(ctx context.Context, in *RecordViewRequest, opts ...grpc.CallOption) (*RecordViewResponse, error) {
+	out := new(RecordViewResponse)
+	err := c.cc.Invoke(ctx, "/blog.BlogService/RecordView", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogServiceClient) GetAuthorStats(ctx context.Context, in *GetAuthorStatsRequest, opts ...grpc.CallOption) (*GetAuthorStatsResponse, error) {
+	out := new(GetAuthorStatsResponse)
+	err := c.cc.Invoke(ctx, "/blog.BlogService/GetAuthorStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogServiceClient) GetPostsPerDay(ctx context.Context, in *GetPostsPerDayRequest, opts ...grpc.CallOption) (*GetPostsPerDayResponse, error) {
+	out := new(GetPostsPerDayResponse)
+	err := c.cc.Invoke(ctx, "/blog.BlogService/GetPostsPerDay", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogServiceClient) ListMostViewedBlogs(ctx context.Context, in *ListMostViewedBlogsRequest, opts ...grpc.CallOption) (*ListMostViewedBlogsResponse, error) {
+	out := new(ListMostViewedBlogsResponse)
+	err := c.cc.Invoke(ctx, "/blog.BlogService/ListMostViewedBlogs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogServiceClient) BatchWriteBlogs(ctx context.Context, in *BatchWriteBlogsRequest, opts ...grpc.CallOption) (*BatchWriteBlogsResponse, error) {
+	out := new(BatchWriteBlogsResponse)
+	err := c.cc.Invoke(ctx, "/blog.BlogService/BatchWriteBlogs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogServiceClient) GetCacheStats(ctx context.Context, in *GetCacheStatsRequest, opts ...grpc.CallOption) (*GetCacheStatsResponse, error) {
+	out := new(GetCacheStatsResponse)
+	err := c.cc.Invoke(ctx, "/blog.BlogService/GetCacheStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BlogServiceServer is the server API for BlogService service.
 // All implementations must embed UnimplementedBlogServiceServer
 // for forward compatibility
 type BlogServiceServer interface {
+	// Unary
+	// if the blog is sent with an id that already exists, it returns ALREADY_EXISTS
+	// if the author_id is not of an existing author, it returns FAILED_PRECONDITION
+	CreateBlog(context.Context, *CreateBlogRequest) (*CreateBlogResponse, error)
+	// Unary
+	// the read_mask of the read and list rpcs chooses the returned Blog fields, like ["title", "tags"]
+	// return NOT_FOUND if the blog is not found
+	// return INVALID_ARGUMENT if the read_mask has a path that is not a Blog field
+	ReadBlog(context.Context, *ReadBlogRequest) (*ReadBlogResponse, error)
+	// Unary
+	// return NOT_FOUND if the blog is not found
+	// return ABORTED if the blog was changed after expected_revision, the client should read it again and retry
+	// return FAILED_PRECONDITION if the author_id is not of an existing author
+	// return INVALID_ARGUMENT if the update_mask has a path that is not a Blog field or is filled by the server
+	UpdateBlog(context.Context, *UpdateBlogRequest) (*UpdateBlogResponse, error)
+	// Unary
+	// moves the blog to the trash, where it is kept until the retention period of the server expires
+	// when permanent is true the blog is removed right away, even if it is in the trash
+	// return NOT_FOUND if the blog is not found
+	// return FAILED_PRECONDITION if permanent is true, the blog has comments and cascade is false
+	DeleteBlog(context.Context, *DeleteBlogRequest) (*DeleteBlogResponse, error)
+	// ServerStreaming
+	// streams the blogs in the trash ordered by id, with the same pagination of ListBlogs
+	ListDeletedBlogs(*ListDeletedBlogsRequest, BlogService_ListDeletedBlogsServer) error
+	// Unary
+	// moves the blog back from the trash
+	// return NOT_FOUND if the blog is not in the trash
+	RestoreBlog(context.Context, *RestoreBlogRequest) (*RestoreBlogResponse, error)
+	// ServerStreaming
+	// streams the blogs ordered by id, up to page_size blogs per call
+	// to get the next page, call it again with the cursor of the last received blog
+	// the blogs can be filtered by author, category and tags, all the filters must match
+	ListBlogs(*ListBlogsRequest, BlogService_ListBlogsServer) error
+	// Unary
+	// returns all the tags of the blogs with how many blogs have each one, the most used first
+	ListTags(context.Context, *ListTagsRequest) (*ListTagsResponse, error)
+	// Unary
+	// moves a draft to review
+	// the workflow rpcs return NOT_FOUND if the blog is not found,
+	// FAILED_PRECONDITION if the blog status doesn't allow the change
+	// and ABORTED if expected_revision is filled and the blog was changed after it
+	SubmitBlogForReview(context.Context, *SubmitBlogForReviewRequest) (*SubmitBlogForReviewResponse, error)
+	// Unary
+	// publishes a blog in review, or schedules it when publish_at is in the future
+	// a scheduled blog can also be published before its time
+	PublishBlog(context.Context, *PublishBlogRequest) (*PublishBlogResponse, error)
+	// Unary
+	// archives a published blog
+	ArchiveBlog(context.Context, *ArchiveBlogRequest) (*ArchiveBlogResponse, error)
+	// Unary
+	// moves the blog back to draft, from any other status
+	ReturnBlogToDraft(context.Context, *ReturnBlogToDraftRequest) (*ReturnBlogToDraftResponse, error)
+	// Unary
+	// converts the markdown content of the blog to sanitised html, with its table of contents and an excerpt
+	// the result is cached until the blog changes
+	// return NOT_FOUND if the blog is not found
+	RenderBlog(context.Context, *RenderBlogRequest) (*RenderBlogResponse, error)
+	// Unary
+	// return all the revisions of the blog, from the oldest to the current one
+	// return NOT_FOUND if the blog is not found
+	GetBlogHistory(context.Context, *GetBlogHistoryRequest) (*GetBlogHistoryResponse, error)
+	// ServerStreaming
+	// streams the blog changes until the client cancels the request
+	// send the resume_token of the last received event to not miss the events that happened while disconnected
+	// return OUT_OF_RANGE if the resume_token is too old, the client should read the blogs again and watch without a token
+	// return RESOURCE_EXHAUSTED if the client is too slow to receive the events, it should reconnect with the last resume_token,
+	// that is also in the ErrorInfo detail
+	WatchBlogs(*WatchBlogsRequest, BlogService_WatchBlogsServer) error
+	// Unary
+	// search the published blogs by the words of the title and content, the best matches come first
+	// words inside double quotes are matched as a phrase, example: grpc "server streaming"
+	SearchBlogs(context.Context, *SearchBlogsRequest) (*SearchBlogsResponse, error)
+	// ClientStreaming
+	// creates each received author and blog keeping its id and timestamps, a record that fails doesn't stop the import
+	// the authors must be sent before their blogs, an author that already exists is kept as it is
+	// the response has the errors of each failed record
+	ImportBlogs(BlogService_ImportBlogsServer) error
+	// ServerStreaming
+	// streams all the authors and then all the blogs, ordered by id, so the export can be imported in an empty server
+	ExportBlogs(*ExportBlogsRequest, BlogService_ExportBlogsServer) error
+	// Unary
+	// if the author is sent with an id that already exists, it returns ALREADY_EXISTS
+	CreateAuthor(context.Context, *CreateAuthorRequest) (*CreateAuthorResponse, error)
+	// Unary
+	// return NOT_FOUND if the author is not found
+	GetAuthor(context.Context, *GetAuthorRequest) (*GetAuthorResponse, error)
+	// ServerStreaming
+	// streams the authors ordered by id, with the same pagination of ListBlogs
+	ListAuthors(*ListAuthorsRequest, BlogService_ListAuthorsServer) error
+	// Unary
+	// return FAILED_PRECONDITION if the blog or the author doesn't exist
+	CreateComment(context.Context, *CreateCommentRequest) (*CreateCommentResponse, error)
+	// ServerStreaming
+	// streams the comments of a blog ordered by id, with the same pagination of ListBlogs
+	// return NOT_FOUND if the blog is not found
+	ListComments(*ListCommentsRequest, BlogService_ListCommentsServer) error
+	// Unary
+	// return NOT_FOUND if the comment is not found
+	DeleteComment(context.Context, *DeleteCommentRequest) (*DeleteCommentResponse, error)
+	// ClientStreaming
+	// the first message must have the metadata, the next ones the chunks of the file
+	// and the last one the sha256 of the whole file, that is checked by the server
+	// return FAILED_PRECONDITION if the blog doesn't exist
+	// return INVALID_ARGUMENT if the file is bigger than the limit of the server or its content type is not allowed
+	// return DATA_LOSS if the sha256 doesn't match the received file
+	UploadAttachment(BlogService_UploadAttachmentServer) error
+	// ServerStreaming
+	// the first message has the attachment, and all of them have a chunk of the file
+	// an interrupted download can be resumed sending the offset of the bytes already received
+	// return NOT_FOUND if the attachment is not found
+	// return OUT_OF_RANGE if the offset is after the end of the file
+	DownloadAttachment(*DownloadAttachmentRequest, BlogService_DownloadAttachmentServer) error
+	// Unary
+	// returns the attachments of the blog, the oldest first
+	ListAttachments(context.Context, *ListAttachmentsRequest) (*ListAttachmentsResponse, error)
+	// Unary
+	// counts one more view of the blog, the clients call it when a reader opens the blog
+	// return NOT_FOUND if the blog is not found
+	RecordView(context.Context, *RecordViewRequest) (*RecordViewResponse, error)
+	// Unary
+	// returns how many published blogs, words and views each author has, the authors with more blogs first
+	// the blogs that are not published or are in the trash are not counted
+	GetAuthorStats(context.Context, *GetAuthorStatsRequest) (*GetAuthorStatsResponse, error)
+	// Unary
+	// returns how many blogs were published in each day of the range, including the days without blogs
+	// the blogs that are not published anymore or are in the trash are not counted
+	// return INVALID_ARGUMENT if the dates or the time zone are invalid, or the range has more than 366 days
+	GetPostsPerDay(context.Context, *GetPostsPerDayRequest) (*GetPostsPerDayResponse, error)
+	// Unary
+	// returns the published blogs with more views, the blogs without views are not returned
+	ListMostViewedBlogs(context.Context, *ListMostViewedBlogsRequest) (*ListMostViewedBlogsResponse, error)
+	// Unary
+	// applies up to 100 create, update and delete operations in order, all of them or none
+	// an operation sees the changes of the previous ones, so a blog can be created and updated in the same batch
+	// the deletes only move the blogs to the trash, permanent deletes are not accepted
+	// return ABORTED if an operation fails, with an ErrorInfo detail that has its index and error code
+	// return RESOURCE_EXHAUSTED if the tenant has no quota for the whole batch
+	// return FAILED_PRECONDITION if the storage is a standalone mongo, the batches need the transactions of a replica set
+	BatchWriteBlogs(context.Context, *BatchWriteBlogsRequest) (*BatchWriteBlogsResponse, error)
+	// Unary
+	// returns the counters of the cache of the blogs and list pages of the tenant, for the monitoring
+	// the counters start at zero when the server starts
+	GetCacheStats(context.Context, *GetCacheStatsRequest) (*GetCacheStatsResponse, error)
 	mustEmbedUnimplementedBlogServiceServer()
 }
 
@@ -35,6 +832,105 @@ type BlogServiceServer interface {
 type UnimplementedBlogServiceServer struct {
 }
 
+func (UnimplementedBlogServiceServer) CreateBlog(context.Context, *CreateBlogRequest) (*CreateBlogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBlog not implemented")
+}
+func (UnimplementedBlogServiceServer) ReadBlog(context.Context, *ReadBlogRequest) (*ReadBlogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadBlog not implemented")
+}
+func (UnimplementedBlogServiceServer) UpdateBlog(context.Context, *UpdateBlogRequest) (*UpdateBlogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBlog not implemented")
+}
+func (UnimplementedBlogServiceServer) DeleteBlog(context.Context, *DeleteBlogRequest) (*DeleteBlogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBlog not implemented")
+}
+func (UnimplementedBlogServiceServer) ListDeletedBlogs(*ListDeletedBlogsRequest, BlogService_ListDeletedBlogsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListDeletedBlogs not implemented")
+}
+func (UnimplementedBlogServiceServer) RestoreBlog(context.Context, *RestoreBlogRequest) (*RestoreBlogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreBlog not implemented")
+}
+func (UnimplementedBlogServiceServer) ListBlogs(*ListBlogsRequest, BlogService_ListBlogsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListBlogs not implemented")
+}
+func (UnimplementedBlogServiceServer) ListTags(context.Context, *ListTagsRequest) (*ListTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTags not implemented")
+}
+func (UnimplementedBlogServiceServer) SubmitBlogForReview(context.Context, *SubmitBlogForReviewRequest) (*SubmitBlogForReviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitBlogForReview not implemented")
+}
+func (UnimplementedBlogServiceServer) PublishBlog(context.Context, *PublishBlogRequest) (*PublishBlogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishBlog not implemented")
+}
+func (UnimplementedBlogServiceServer) ArchiveBlog(context.Context, *ArchiveBlogRequest) (*ArchiveBlogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchiveBlog not implemented")
+}
+func (UnimplementedBlogServiceServer) ReturnBlogToDraft(context.Context, *ReturnBlogToDraftRequest) (*ReturnBlogToDraftResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReturnBlogToDraft not implemented")
+}
+func (UnimplementedBlogServiceServer) RenderBlog(context.Context, *RenderBlogRequest) (*RenderBlogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenderBlog not implemented")
+}
+func (UnimplementedBlogServiceServer) GetBlogHistory(context.Context, *GetBlogHistoryRequest) (*GetBlogHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlogHistory not implemented")
+}
+func (UnimplementedBlogServiceServer) WatchBlogs(*WatchBlogsRequest, BlogService_WatchBlogsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchBlogs not implemented")
+}
+func (UnimplementedBlogServiceServer) SearchBlogs(context.Context, *SearchBlogsRequest) (*SearchBlogsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchBlogs not implemented")
+}
+func (UnimplementedBlogServiceServer) ImportBlogs(BlogService_ImportBlogsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ImportBlogs not implemented")
+}
+func (UnimplementedBlogServiceServer) ExportBlogs(*ExportBlogsRequest, BlogService_ExportBlogsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportBlogs not implemented")
+}
+func (UnimplementedBlogServiceServer) CreateAuthor(context.Context, *CreateAuthorRequest) (*CreateAuthorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAuthor not implemented")
+}
+func (UnimplementedBlogServiceServer) GetAuthor(context.Context, *GetAuthorRequest) (*GetAuthorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAuthor not implemented")
+}
+func (UnimplementedBlogServiceServer) ListAuthors(*ListAuthorsRequest, BlogService_ListAuthorsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListAuthors not implemented")
+}
+func (UnimplementedBlogServiceServer) CreateComment(context.Context, *CreateCommentRequest) (*CreateCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateComment not implemented")
+}
+func (UnimplementedBlogServiceServer) ListComments(*ListCommentsRequest, BlogService_ListCommentsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListComments not implemented")
+}
+func (UnimplementedBlogServiceServer) DeleteComment(context.Context, *DeleteCommentRequest) (*DeleteCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteComment not implemented")
+}
+func (UnimplementedBlogServiceServer) UploadAttachment(BlogService_UploadAttachmentServer) error {
+	return status.Errorf(codes.Unimplemented, "method UploadAttachment not implemented")
+}
+func (UnimplementedBlogServiceServer) DownloadAttachment(*DownloadAttachmentRequest, BlogService_DownloadAttachmentServer) error {
+	return status.Errorf(codes.Unimplemented, "method DownloadAttachment not implemented")
+}
+func (UnimplementedBlogServiceServer) ListAttachments(context.Context, *ListAttachmentsRequest) (*ListAttachmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAttachments not implemented")
+}
+func (UnimplementedBlogServiceServer) RecordView(context.Context, *RecordViewRequest) (*RecordViewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordView not implemented")
+}
+func (UnimplementedBlogServiceServer) GetAuthorStats(context.Context, *GetAuthorStatsRequest) (*GetAuthorStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAuthorStats not implemented")
+}
+func (UnimplementedBlogServiceServer) GetPostsPerDay(context.Context, *GetPostsPerDayRequest) (*GetPostsPerDayResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPostsPerDay not implemented")
+}
+func (UnimplementedBlogServiceServer) ListMostViewedBlogs(context.Context, *ListMostViewedBlogsRequest) (*ListMostViewedBlogsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMostViewedBlogs not implemented")
+}
+func (UnimplementedBlogServiceServer) BatchWriteBlogs(context.Context, *BatchWriteBlogsRequest) (*BatchWriteBlogsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchWriteBlogs not implemented")
+}
+func (UnimplementedBlogServiceServer) GetCacheStats(context.Context, *GetCacheStatsRequest) (*GetCacheStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCacheStats not implemented")
+}
 func (UnimplementedBlogServiceServer) mustEmbedUnimplementedBlogServiceServer() {}
 
 // UnsafeBlogServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -48,10 +944,784 @@ func RegisterBlogServiceServer(s grpc.ServiceRegistrar, srv BlogServiceServer) {
 	s.RegisterService(&_BlogService_serviceDesc, srv)
 }
 
+func _BlogService_CreateBlog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBlogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).CreateBlog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blog.BlogService/CreateBlog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).CreateBlog(ctx, req.(*CreateBlogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_ReadBlog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadBlogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).ReadBlog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blog.BlogService/ReadBlog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).ReadBlog(ctx, req.(*ReadBlogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_UpdateBlog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBlogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).UpdateBlog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blog.BlogService/UpdateBlog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).UpdateBlog(ctx, req.(*UpdateBlogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_DeleteBlog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBlogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).DeleteBlog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blog.BlogService/DeleteBlog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).DeleteBlog(ctx, req.(*DeleteBlogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_ListDeletedBlogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListDeletedBlogsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BlogServiceServer).ListDeletedBlogs(m, &blogServiceListDeletedBlogsServer{stream})
+}
+
+type BlogService_ListDeletedBlogsServer interface {
+	Send(*ListDeletedBlogsResponse) error
+	grpc.ServerStream
+}
+
+type blogServiceListDeletedBlogsServer struct {
+	grpc.ServerStream
+}
+
+func (x *blogServiceListDeletedBlogsServer) Send(m *ListDeletedBlogsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _BlogService_RestoreBlog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreBlogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).RestoreBlog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blog.BlogService/RestoreBlog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).RestoreBlog(ctx, req.(*RestoreBlogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_ListBlogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListBlogsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BlogServiceServer).ListBlogs(m, &blogServiceListBlogsServer{stream})
+}
+
+type BlogService_ListBlogsServer interface {
+	Send(*ListBlogsResponse) error
+	grpc.ServerStream
+}
+
+type blogServiceListBlogsServer struct {
+	grpc.ServerStream
+}
+
+func (x *blogServiceListBlogsServer) Send(m *ListBlogsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _BlogService_ListTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).ListTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blog.BlogService/ListTags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).ListTags(ctx, req.(*ListTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_SubmitBlogForReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitBlogForReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).SubmitBlogForReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blog.BlogService/SubmitBlogForReview",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).SubmitBlogForReview(ctx, req.(*SubmitBlogForReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_PublishBlog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishBlogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).PublishBlog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blog.BlogService/PublishBlog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).PublishBlog(ctx, req.(*PublishBlogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_ArchiveBlog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchiveBlogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).ArchiveBlog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blog.BlogService/ArchiveBlog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).ArchiveBlog(ctx, req.(*ArchiveBlogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_ReturnBlogToDraft_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReturnBlogToDraftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).ReturnBlogToDraft(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blog.BlogService/ReturnBlogToDraft",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).ReturnBlogToDraft(ctx, req.(*ReturnBlogToDraftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_RenderBlog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenderBlogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).RenderBlog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blog.BlogService/RenderBlog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).RenderBlog(ctx, req.(*RenderBlogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_GetBlogHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlogHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).GetBlogHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blog.BlogService/GetBlogHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).GetBlogHistory(ctx, req.(*GetBlogHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_WatchBlogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchBlogsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BlogServiceServer).WatchBlogs(m, &blogServiceWatchBlogsServer{stream})
+}
+
+type BlogService_WatchBlogsServer interface {
+	Send(*WatchBlogsResponse) error
+	grpc.ServerStream
+}
+
+type blogServiceWatchBlogsServer struct {
+	grpc.ServerStream
+}
+
+func (x *blogServiceWatchBlogsServer) Send(m *WatchBlogsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _BlogService_SearchBlogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchBlogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).SearchBlogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blog.BlogService/SearchBlogs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).SearchBlogs(ctx, req.(*SearchBlogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_ImportBlogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(BlogServiceServer).ImportBlogs(&blogServiceImportBlogsServer{stream})
+}
+
+type BlogService_ImportBlogsServer interface {
+	SendAndClose(*ImportBlogsResponse) error
+	Recv() (*ImportBlogsRequest, error)
+	grpc.ServerStream
+}
+
+type blogServiceImportBlogsServer struct {
+	grpc.ServerStream
+}
+
+func (x *blogServiceImportBlogsServer) SendAndClose(m *ImportBlogsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *blogServiceImportBlogsServer) Recv() (*ImportBlogsRequest, error) {
+	m := new(ImportBlogsRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _BlogService_ExportBlogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportBlogsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BlogServiceServer).ExportBlogs(m, &blogServiceExportBlogsServer{stream})
+}
+
+type BlogService_ExportBlogsServer interface {
+	Send(*ExportBlogsResponse) error
+	grpc.ServerStream
+}
+
+type blogServiceExportBlogsServer struct {
+	grpc.ServerStream
+}
+
+func (x *blogServiceExportBlogsServer) Send(m *ExportBlogsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _BlogService_CreateAuthor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAuthorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).CreateAuthor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blog.BlogService/CreateAuthor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).CreateAuthor(ctx, req.(*CreateAuthorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_GetAuthor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAuthorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).GetAuthor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blog.BlogService/GetAuthor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).GetAuthor(ctx, req.(*GetAuthorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_ListAuthors_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListAuthorsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BlogServiceServer).ListAuthors(m, &blogServiceListAuthorsServer{stream})
+}
+
+type BlogService_ListAuthorsServer interface {
+	Send(*ListAuthorsResponse) error
+	grpc.ServerStream
+}
+
+type blogServiceListAuthorsServer struct {
+	grpc.ServerStream
+}
+
+func (x *blogServiceListAuthorsServer) Send(m *ListAuthorsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _BlogService_CreateComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).CreateComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blog.BlogService/CreateComment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).CreateComment(ctx, req.(*CreateCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_ListComments_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListCommentsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BlogServiceServer).ListComments(m, &blogServiceListCommentsServer{stream})
+}
+
+type BlogService_ListCommentsServer interface {
+	Send(*ListCommentsResponse) error
+	grpc.ServerStream
+}
+
+type blogServiceListCommentsServer struct {
+	grpc.ServerStream
+}
+
+func (x *blogServiceListCommentsServer) Send(m *ListCommentsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _BlogService_DeleteComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).DeleteComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blog.BlogService/DeleteComment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).DeleteComment(ctx, req.(*DeleteCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_UploadAttachment_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(BlogServiceServer).UploadAttachment(&blogServiceUploadAttachmentServer{stream})
+}
+
+type BlogService_UploadAttachmentServer interface {
+	SendAndClose(*UploadAttachmentResponse) error
+	Recv() (*UploadAttachmentRequest, error)
+	grpc.ServerStream
+}
+
+type blogServiceUploadAttachmentServer struct {
+	grpc.ServerStream
+}
+
+func (x *blogServiceUploadAttachmentServer) SendAndClose(m *UploadAttachmentResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *blogServiceUploadAttachmentServer) Recv() (*UploadAttachmentRequest, error) {
+	m := new(UploadAttachmentRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _BlogService_DownloadAttachment_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DownloadAttachmentRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BlogServiceServer).DownloadAttachment(m, &blogServiceDownloadAttachmentServer{stream})
+}
+
+type BlogService_DownloadAttachmentServer interface {
+	Send(*DownloadAttachmentResponse) error
+	grpc.ServerStream
+}
+
+type blogServiceDownloadAttachmentServer struct {
+	grpc.ServerStream
+}
+
+func (x *blogServiceDownloadAttachmentServer) Send(m *DownloadAttachmentResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _BlogService_ListAttachments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAttachmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).ListAttachments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blog.BlogService/ListAttachments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).ListAttachments(ctx, req.(*ListAttachmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_RecordView_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordViewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).RecordView(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blog.BlogService/RecordView",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).RecordView(ctx, req.(*RecordViewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_GetAuthorStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAuthorStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).GetAuthorStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blog.BlogService/GetAuthorStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).GetAuthorStats(ctx, req.(*GetAuthorStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_GetPostsPerDay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPostsPerDayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).GetPostsPerDay(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blog.BlogService/GetPostsPerDay",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).GetPostsPerDay(ctx, req.(*GetPostsPerDayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_ListMostViewedBlogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMostViewedBlogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).ListMostViewedBlogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blog.BlogService/ListMostViewedBlogs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).ListMostViewedBlogs(ctx, req.(*ListMostViewedBlogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_BatchWriteBlogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchWriteBlogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).BatchWriteBlogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blog.BlogService/BatchWriteBlogs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).BatchWriteBlogs(ctx, req.(*BatchWriteBlogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_GetCacheStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCacheStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).GetCacheStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blog.BlogService/GetCacheStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).GetCacheStats(ctx, req.(*GetCacheStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _BlogService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "blog.BlogService",
 	HandlerType: (*BlogServiceServer)(nil),
-	Methods:     []grpc.MethodDesc{},
-	Streams:     []grpc.StreamDesc{},
-	Metadata:    "blog/blogpb/blog.proto",
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateBlog",
+			Handler:    _BlogService_CreateBlog_Handler,
+		},
+		{
+			MethodName: "ReadBlog",
+			Handler:    _BlogService_ReadBlog_Handler,
+		},
+		{
+			MethodName: "UpdateBlog",
+			Handler:    _BlogService_UpdateBlog_Handler,
+		},
+		{
+			MethodName: "DeleteBlog",
+			Handler:    _BlogService_DeleteBlog_Handler,
+		},
+		{
+			MethodName: "RestoreBlog",
+			Handler:    _BlogService_RestoreBlog_Handler,
+		},
+		{
+			MethodName: "ListTags",
+			Handler:    _BlogService_ListTags_Handler,
+		},
+		{
+			MethodName: "SubmitBlogForReview",
+			Handler:    _BlogService_SubmitBlogForReview_Handler,
+		},
+		{
+			MethodName: "PublishBlog",
+			Handler:    _BlogService_PublishBlog_Handler,
+		},
+		{
+			MethodName: "ArchiveBlog",
+			Handler:    _BlogService_ArchiveBlog_Handler,
+		},
+		{
+			MethodName: "ReturnBlogToDraft",
+			Handler:    _BlogService_ReturnBlogToDraft_Handler,
+		},
+		{
+			MethodName: "RenderBlog",
+			Handler:    _BlogService_RenderBlog_Handler,
+		},
+		{
+			MethodName: "GetBlogHistory",
+			Handler:    _BlogService_GetBlogHistory_Handler,
+		},
+		{
+			MethodName: "SearchBlogs",
+			Handler:    _BlogService_SearchBlogs_Handler,
+		},
+		{
+			MethodName: "CreateAuthor",
+			Handler:    _BlogService_CreateAuthor_Handler,
+		},
+		{
+			MethodName: "GetAuthor",
+			Handler:    _BlogService_GetAuthor_Handler,
+		},
+		{
+			MethodName: "CreateComment",
+			Handler:    _BlogService_CreateComment_Handler,
+		},
+		{
+			MethodName: "DeleteComment",
+			Handler:    _BlogService_DeleteComment_Handler,
+		},
+		{
+			MethodName: "ListAttachments",
+			Handler:    _BlogService_ListAttachments_Handler,
+		},
+		{
+			MethodName: "RecordView",
+			Handler:    _BlogService_RecordView_Handler,
+		},
+		{
+			MethodName: "GetAuthorStats",
+			Handler:    _BlogService_GetAuthorStats_Handler,
+		},
+		{
+			MethodName: "GetPostsPerDay",
+			Handler:    _BlogService_GetPostsPerDay_Handler,
+		},
+		{
+			MethodName: "ListMostViewedBlogs",
+			Handler:    _BlogService_ListMostViewedBlogs_Handler,
+		},
+		{
+			MethodName: "BatchWriteBlogs",
+			Handler:    _BlogService_BatchWriteBlogs_Handler,
+		},
+		{
+			MethodName: "GetCacheStats",
+			Handler:    _BlogService_GetCacheStats_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ListDeletedBlogs",
+			Handler:       _BlogService_ListDeletedBlogs_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListBlogs",
+			Handler:       _BlogService_ListBlogs_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchBlogs",
+			Handler:       _BlogService_WatchBlogs_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ImportBlogs",
+			Handler:       _BlogService_ImportBlogs_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ExportBlogs",
+			Handler:       _BlogService_ExportBlogs_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListAuthors",
+			Handler:       _BlogService_ListAuthors_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListComments",
+			Handler:       _BlogService_ListComments_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "UploadAttachment",
+			Handler:       _BlogService_UploadAttachment_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "DownloadAttachment",
+			Handler:       _BlogService_DownloadAttachment_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "blog/blogpb/blog.proto",
 }
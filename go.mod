@@ -4,10 +4,11 @@ go 1.15
 
 require (
 	github.com/golang/protobuf v1.4.3
+	go.mongodb.org/mongo-driver v1.4.4
 	golang.org/x/net v0.0.0-20201110031124-69a78807bb2b // indirect
 	golang.org/x/sys v0.0.0-20201126233918-771906719818 // indirect
 	golang.org/x/text v0.3.4 // indirect
-	google.golang.org/genproto v0.0.0-20201119123407-9b1e624d6bc4 // indirect
+	google.golang.org/genproto v0.0.0-20201119123407-9b1e624d6bc4
 	google.golang.org/grpc v1.33.2
 	google.golang.org/protobuf v1.25.0
 )